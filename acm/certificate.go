@@ -6,6 +6,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	awsacm "github.com/aws/aws-sdk-go/service/acm"
+	"github.com/turnerlabs/fargate/awserrors"
 )
 
 // Certificate is a certificate hosted in AWS Certificate Manager.
@@ -132,7 +133,7 @@ func (acm SDKClient) DeleteCertificate(arn string) error {
 	}
 
 	if _, err := acm.client.DeleteCertificate(input); err != nil {
-		return err
+		return awserrors.Wrap(err)
 	}
 
 	return nil
@@ -152,7 +153,7 @@ func (acm SDKClient) ImportCertificate(certificate, privateKey, certificateChain
 
 	resp, err := acm.client.ImportCertificate(input)
 
-	return aws.StringValue(resp.CertificateArn), err
+	return aws.StringValue(resp.CertificateArn), awserrors.Wrap(err)
 }
 
 // InflateCertificate uses a partially hydrated certificate to fetch the rest of its details and
@@ -165,7 +166,7 @@ func (acm SDKClient) InflateCertificate(c *Certificate) error {
 	)
 
 	if err != nil {
-		return err
+		return awserrors.Wrap(err)
 	}
 
 	c.Status = aws.StringValue(resp.Certificate.Status)
@@ -212,7 +213,7 @@ func (acm SDKClient) ListCertificates() (Certificates, error) {
 
 	err := acm.client.ListCertificatesPages(input, handler)
 
-	return certificates, err
+	return certificates, awserrors.Wrap(err)
 }
 
 // RequestCertificate creates a new certificate.
@@ -229,7 +230,7 @@ func (acm SDKClient) RequestCertificate(domainName string, aliases []string) (st
 	resp, err := acm.client.RequestCertificate(requestCertificateInput)
 
 	if err != nil {
-		return "", err
+		return "", awserrors.Wrap(err)
 	}
 
 	return aws.StringValue(resp.CertificateArn), nil
@@ -0,0 +1,169 @@
+// Package awserrors classifies the errors returned by the AWS wrappers, so that
+// callers can tell, for example, a missing resource from a throttled request
+// without matching AWS error codes themselves.
+package awserrors
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+)
+
+// Kind is the reason a call to AWS failed.
+type Kind int
+
+const (
+	// Unknown is any failure not classified otherwise.
+	Unknown Kind = iota
+	// NotFound means a resource does not exist.
+	NotFound
+	// Throttled means the request was rate limited and can be retried later.
+	Throttled
+	// AccessDenied means the credentials are missing, expired or not allowed
+	// to make the request.
+	AccessDenied
+	// InvalidInput means a parameter of the request was rejected.
+	InvalidInput
+)
+
+// String returns the name of the kind.
+func (k Kind) String() string {
+	switch k {
+	case NotFound:
+		return "not found"
+	case Throttled:
+		return "throttled"
+	case AccessDenied:
+		return "access denied"
+	case InvalidInput:
+		return "invalid input"
+	}
+
+	return "unknown"
+}
+
+// Error is a classified error. Its message is the message of the underlying
+// error, if any.
+type Error struct {
+	Kind Kind
+	Err  error
+}
+
+func (e *Error) Error() string {
+	return e.Err.Error()
+}
+
+// Unwrap returns the underlying error, e.g. an awserr.Error.
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// Wrap classifies an error returned by the AWS SDK. It returns nil for a nil
+// error, and errors that are already classified unchanged.
+func Wrap(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	var classified *Error
+	if errors.As(err, &classified) {
+		return err
+	}
+
+	return &Error{Kind: classify(err), Err: err}
+}
+
+// New returns an error of a kind that was detected without an AWS error, e.g.
+// an empty result for a resource that was asked for by name.
+func New(kind Kind, format string, a ...interface{}) error {
+	return &Error{Kind: kind, Err: fmt.Errorf(format, a...)}
+}
+
+// KindOf returns the kind of an error, or Unknown if it is not classified.
+func KindOf(err error) Kind {
+	var classified *Error
+	if errors.As(err, &classified) {
+		return classified.Kind
+	}
+
+	return Unknown
+}
+
+// IsNotFound returns whether an error means that a resource does not exist.
+func IsNotFound(err error) bool {
+	return KindOf(err) == NotFound
+}
+
+// IsThrottled returns whether an error means that a request was rate limited.
+func IsThrottled(err error) bool {
+	return KindOf(err) == Throttled
+}
+
+// IsAccessDenied returns whether an error means that a request was not allowed.
+func IsAccessDenied(err error) bool {
+	return KindOf(err) == AccessDenied
+}
+
+// IsInvalidInput returns whether an error means that a parameter was rejected.
+func IsInvalidInput(err error) bool {
+	return KindOf(err) == InvalidInput
+}
+
+// the AWS error codes that are not classified by their name alone
+var codes = map[string]Kind{
+	"NoSuchEntity":                  NotFound,
+	"ServiceNotActiveException":     NotFound,
+	"AuthFailure":                   AccessDenied,
+	"ExpiredToken":                  AccessDenied,
+	"ExpiredTokenException":         AccessDenied,
+	"InvalidClientTokenId":          AccessDenied,
+	"NoCredentialProviders":         AccessDenied,
+	"UnrecognizedClientException":   AccessDenied,
+	"MissingParameter":              InvalidInput,
+	"MalformedPolicyDocument":       InvalidInput,
+	"LimitExceededException":        InvalidInput, // a quota, e.g. on metric filters per log group, was reached
+	request.InvalidParameterErrCode: InvalidInput,
+	request.ParamRequiredErrCode:    InvalidInput,
+}
+
+func classify(err error) Kind {
+	var aerr awserr.Error
+	if !errors.As(err, &aerr) {
+		return Unknown
+	}
+
+	code := aerr.Code()
+
+	if kind, ok := codes[code]; ok {
+		return kind
+	}
+
+	switch {
+	case strings.Contains(code, "NotFound"):
+		return NotFound
+	case request.IsErrorThrottle(aerr):
+		return Throttled
+	case strings.HasPrefix(code, "AccessDenied"), strings.HasPrefix(code, "Unauthorized"):
+		return AccessDenied
+	case strings.HasPrefix(code, "Invalid"), strings.HasPrefix(code, "Validation"):
+		return InvalidInput
+	}
+
+	var failure awserr.RequestFailure
+	if errors.As(err, &failure) {
+		switch failure.StatusCode() {
+		case http.StatusNotFound:
+			return NotFound
+		case http.StatusTooManyRequests:
+			return Throttled
+		case http.StatusForbidden:
+			return AccessDenied
+		}
+	}
+
+	return Unknown
+}
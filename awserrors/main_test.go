@@ -0,0 +1,69 @@
+package awserrors
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+)
+
+func TestWrap(t *testing.T) {
+	tests := []struct {
+		err  error
+		kind Kind
+	}{
+		{awserr.New("ClusterNotFoundException", "Cluster not found.", nil), NotFound},
+		{awserr.New("ResourceNotFoundException", "The specified log group does not exist.", nil), NotFound},
+		{awserr.New("InvalidNetworkInterfaceID.NotFound", "The networkInterface ID 'eni-1' does not exist", nil), NotFound},
+		{awserr.New("ServiceNotActiveException", "Service was not ACTIVE.", nil), NotFound},
+		{awserr.New("ThrottlingException", "Rate exceeded", nil), Throttled},
+		{awserr.New("RequestLimitExceeded", "Request limit exceeded.", nil), Throttled},
+		{awserr.New("AccessDeniedException", "User is not authorized", nil), AccessDenied},
+		{awserr.New("UnauthorizedOperation", "You are not authorized", nil), AccessDenied},
+		{awserr.New("ExpiredTokenException", "The security token included in the request is expired", nil), AccessDenied},
+		{awserr.New("InvalidParameterException", "Invalid revision number.", nil), InvalidInput},
+		{awserr.New("ValidationError", "1 validation error detected", nil), InvalidInput},
+		{awserr.New("LimitExceededException", "Resource limit exceeded.", nil), InvalidInput},
+		{awserr.NewRequestFailure(awserr.New("Unknown", "", nil), 429, "1"), Throttled},
+		{awserr.New("ServerException", "Server error", nil), Unknown},
+		{errors.New("connection refused"), Unknown},
+		{fmt.Errorf("describe: %w", awserr.New("ClusterNotFoundException", "Cluster not found.", nil)), NotFound},
+	}
+
+	for _, test := range tests {
+		err := Wrap(test.err)
+
+		if kind := KindOf(err); kind != test.kind {
+			t.Errorf("Expected %s for %v, got %s", test.kind, test.err, kind)
+		}
+
+		if !errors.Is(err, test.err) {
+			t.Errorf("Expected %v to wrap %v", err, test.err)
+		}
+
+		if err.Error() != test.err.Error() {
+			t.Errorf("Expected %s, got %s", test.err.Error(), err.Error())
+		}
+	}
+
+	if Wrap(nil) != nil {
+		t.Error("Expected nil, got an error")
+	}
+}
+
+func TestWrapClassified(t *testing.T) {
+	err := New(NotFound, "service %s not found", "web")
+
+	if Wrap(err) != err {
+		t.Errorf("Expected %v to be returned unchanged", err)
+	}
+
+	if !IsNotFound(err) || IsThrottled(err) || IsAccessDenied(err) || IsInvalidInput(err) {
+		t.Errorf("Expected only not found, got %s", KindOf(err))
+	}
+
+	if err.Error() != "service web not found" {
+		t.Errorf("Expected service web not found, got %s", err.Error())
+	}
+}
@@ -0,0 +1,127 @@
+package cloudwatch
+
+import (
+	"sort"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/turnerlabs/fargate/awserrors"
+)
+
+// Alarm represents a cloudwatch alarm on a single metric
+type Alarm struct {
+	Name               string
+	Description        string
+	Namespace          string
+	MetricName         string
+	Dimensions         map[string]string
+	Statistic          string
+	Period             int64
+	EvaluationPeriods  int64
+	Threshold          float64
+	ComparisonOperator string
+	TreatMissingData   string
+	AlarmActions       []string
+	State              string
+}
+
+// PutMetricAlarm creates or updates an alarm
+func (c *CloudWatch) PutMetricAlarm(alarm Alarm) error {
+	input := &cloudwatch.PutMetricAlarmInput{
+		AlarmName:          aws.String(alarm.Name),
+		Namespace:          aws.String(alarm.Namespace),
+		MetricName:         aws.String(alarm.MetricName),
+		Statistic:          aws.String(alarm.Statistic),
+		Period:             aws.Int64(alarm.Period),
+		EvaluationPeriods:  aws.Int64(alarm.EvaluationPeriods),
+		Threshold:          aws.Float64(alarm.Threshold),
+		ComparisonOperator: aws.String(alarm.ComparisonOperator),
+		AlarmActions:       aws.StringSlice(alarm.AlarmActions),
+	}
+
+	if alarm.Description != "" {
+		input.SetAlarmDescription(alarm.Description)
+	}
+
+	if alarm.TreatMissingData != "" {
+		input.SetTreatMissingData(alarm.TreatMissingData)
+	}
+
+	for _, name := range sortedKeys(alarm.Dimensions) {
+		input.Dimensions = append(input.Dimensions,
+			&cloudwatch.Dimension{
+				Name:  aws.String(name),
+				Value: aws.String(alarm.Dimensions[name]),
+			},
+		)
+	}
+
+	_, err := c.svc.PutMetricAlarm(input)
+
+	return awserrors.Wrap(err)
+}
+
+// DeleteAlarm removes an alarm
+func (c *CloudWatch) DeleteAlarm(name string) error {
+	_, err := c.svc.DeleteAlarms(
+		&cloudwatch.DeleteAlarmsInput{
+			AlarmNames: aws.StringSlice([]string{name}),
+		},
+	)
+
+	return awserrors.Wrap(err)
+}
+
+// DescribeAlarms returns the metric alarms whose names start with a prefix
+func (c *CloudWatch) DescribeAlarms(namePrefix string) ([]Alarm, error) {
+	var alarms []Alarm
+
+	err := c.svc.DescribeAlarmsPages(
+		&cloudwatch.DescribeAlarmsInput{
+			AlarmNamePrefix: aws.String(namePrefix),
+		},
+		func(resp *cloudwatch.DescribeAlarmsOutput, lastPage bool) bool {
+			for _, alarm := range resp.MetricAlarms {
+				dimensions := make(map[string]string)
+
+				for _, dimension := range alarm.Dimensions {
+					dimensions[aws.StringValue(dimension.Name)] = aws.StringValue(dimension.Value)
+				}
+
+				alarms = append(alarms,
+					Alarm{
+						Name:               aws.StringValue(alarm.AlarmName),
+						Description:        aws.StringValue(alarm.AlarmDescription),
+						Namespace:          aws.StringValue(alarm.Namespace),
+						MetricName:         aws.StringValue(alarm.MetricName),
+						Dimensions:         dimensions,
+						Statistic:          aws.StringValue(alarm.Statistic),
+						Period:             aws.Int64Value(alarm.Period),
+						EvaluationPeriods:  aws.Int64Value(alarm.EvaluationPeriods),
+						Threshold:          aws.Float64Value(alarm.Threshold),
+						ComparisonOperator: aws.StringValue(alarm.ComparisonOperator),
+						TreatMissingData:   aws.StringValue(alarm.TreatMissingData),
+						AlarmActions:       aws.StringValueSlice(alarm.AlarmActions),
+						State:              aws.StringValue(alarm.StateValue),
+					},
+				)
+			}
+
+			return true
+		},
+	)
+
+	return alarms, awserrors.Wrap(err)
+}
+
+func sortedKeys(m map[string]string) []string {
+	var keys []string
+
+	for key := range m {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
@@ -0,0 +1,26 @@
+package cloudwatch
+
+//go:generate mockgen -package sdk -source ../vendor/github.com/aws/aws-sdk-go/service/cloudwatch/cloudwatchiface/interface.go -destination=mock/sdk/cloudwatchiface.go github.com/aws/aws-sdk-go/service/cloudwatch/cloudwatchiface CloudWatchAPI
+
+import (
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/aws/aws-sdk-go/service/cloudwatch/cloudwatchiface"
+)
+
+// CloudWatch represents the cloudwatch api
+type CloudWatch struct {
+	svc cloudwatchiface.CloudWatchAPI
+}
+
+// New creates a new cloudwatch client
+func New(sess *session.Session) CloudWatch {
+	return NewWithClient(cloudwatch.New(sess))
+}
+
+// NewWithClient creates a cloudwatch client that calls the given api
+func NewWithClient(client cloudwatchiface.CloudWatchAPI) CloudWatch {
+	return CloudWatch{
+		svc: client,
+	}
+}
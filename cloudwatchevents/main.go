@@ -1,59 +1,60 @@
 package cloudwatchevents
 
-import (
-	"fmt"
+//go:generate mockgen -package sdk -source ../vendor/github.com/aws/aws-sdk-go/service/cloudwatchevents/cloudwatcheventsiface/interface.go -destination=mock/sdk/cloudwatcheventsiface.go github.com/aws/aws-sdk-go/service/cloudwatchevents/cloudwatcheventsiface CloudWatchEventsAPI
 
+import (
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/cloudwatchevents"
-	"github.com/turnerlabs/fargate/console"
+	"github.com/aws/aws-sdk-go/service/cloudwatchevents/cloudwatcheventsiface"
+	"github.com/turnerlabs/fargate/awserrors"
 )
 
 //CloudWatchEvents represents the cloudwatchevents api
 type CloudWatchEvents struct {
-	svc *cloudwatchevents.CloudWatchEvents
+	svc cloudwatcheventsiface.CloudWatchEventsAPI
 }
 
 //New creates a new cloudwatchevents client
 func New(sess *session.Session) CloudWatchEvents {
+	return NewWithClient(cloudwatchevents.New(sess))
+}
+
+//NewWithClient creates a cloudwatchevents client that calls the given api
+func NewWithClient(client cloudwatcheventsiface.CloudWatchEventsAPI) CloudWatchEvents {
 	return CloudWatchEvents{
-		svc: cloudwatchevents.New(sess),
+		svc: client,
 	}
 }
 
-//UpdateTargetRevision updates a cloudwatch events rule target
-func (c *CloudWatchEvents) UpdateTargetRevision(rule string, revision string) {
+//UpdateTargetTaskDefinitions updates the task definitions of rule targets,
+//given a map of target id to task definition arn. All other target settings
+//are preserved.
+func (c *CloudWatchEvents) UpdateTargetTaskDefinitions(rule string, taskDefinitionArns map[string]string) error {
+	var targets []*cloudwatchevents.Target
 
-	//fetch existing rule targets
-	input := &cloudwatchevents.ListTargetsByRuleInput{
-		Rule: &rule,
-	}
-	resp, err := c.svc.ListTargetsByRule(input)
+	sdkTargets, err := c.listTargets(rule)
 	if err != nil {
-		console.ErrorExit(err, "ListTargetsByRuleInput failed")
-	}
-	if len(resp.Targets) == 0 {
-		console.IssueExit("no targets found for rule: ", rule)
+		return err
 	}
 
-	//update the task definition revision
-	resp.Targets[0].EcsParameters.TaskDefinitionArn = &revision
+	for _, target := range sdkTargets {
+		taskDefinitionArn, ok := taskDefinitionArns[aws.StringValue(target.Id)]
+		if !ok {
+			continue
+		}
+
+		if target.EcsParameters == nil {
+			return awserrors.New(awserrors.InvalidInput, "target %s of rule %s is not an ECS task", aws.StringValue(target.Id), rule)
+		}
 
-	//update the rule target
-	putInput := &cloudwatchevents.PutTargetsInput{
-		Rule:    &rule,
-		Targets: resp.Targets,
-	}
-	putResp, err := c.svc.PutTargets(putInput)
-	if err != nil {
-		console.ErrorExit(err, "PutTargets failed")
+		target.EcsParameters.TaskDefinitionArn = aws.String(taskDefinitionArn)
+		targets = append(targets, target)
 	}
-	if putResp != nil {
-		if *putResp.FailedEntryCount != 0 && len(putResp.FailedEntries) != 0 {
-			for _, entry := range putResp.FailedEntries {
-				fmt.Printf("TargetId: %s; ErrorCode: %s; ErrorMessage: %s", *entry.TargetId, *entry.ErrorCode, *entry.ErrorMessage)
-				fmt.Println()
-			}
-			console.IssueExit("PutTargets failed")
-		}
+
+	if len(targets) != len(taskDefinitionArns) {
+		return awserrors.New(awserrors.NotFound, "could not find all targets of rule %s", rule)
 	}
+
+	return c.putTargets(rule, targets)
 }
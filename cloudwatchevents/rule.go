@@ -0,0 +1,369 @@
+package cloudwatchevents
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatchevents"
+	"github.com/turnerlabs/fargate/awserrors"
+)
+
+// Rule represents a cloudwatch events rule
+type Rule struct {
+	Arn                string
+	Description        string
+	Name               string
+	ScheduleExpression string
+	State              string
+}
+
+// Target represents a cloudwatch events rule target that runs an ECS task
+type Target struct {
+	Id                       string
+	ClusterArn               string
+	RoleArn                  string
+	TaskDefinitionArn        string
+	TaskCount                int64
+	LaunchType               string
+	CapacityProviderStrategy []CapacityProviderStrategyItem
+	PlatformVersion          string
+	Group                    string
+	SubnetIds                []string
+	SecurityGroupIds         []string
+	AssignPublicIp           string
+	Input                    string
+}
+
+// CapacityProviderStrategyItem represents a capacity provider of the strategy
+// a target runs its tasks with instead of a launch type
+type CapacityProviderStrategyItem struct {
+	CapacityProvider string
+	Base             int64
+	Weight           int64
+}
+
+// PutRuleInput holds the parameters for creating or updating a scheduled rule
+type PutRuleInput struct {
+	Name               string
+	Description        string
+	ScheduleExpression string
+}
+
+// PutRule creates or updates a scheduled rule and returns its arn. New rules are
+// enabled; existing rules keep their state.
+func (c *CloudWatchEvents) PutRule(i *PutRuleInput) (string, error) {
+	state := cloudwatchevents.RuleStateEnabled
+
+	rule, err := c.DescribeRule(i.Name)
+	if err == nil {
+		state = rule.State
+	} else if !awserrors.IsNotFound(err) {
+		return "", err
+	}
+
+	input := &cloudwatchevents.PutRuleInput{
+		Name:               aws.String(i.Name),
+		ScheduleExpression: aws.String(i.ScheduleExpression),
+		State:              aws.String(state),
+	}
+
+	if i.Description != "" {
+		input.SetDescription(i.Description)
+	}
+
+	resp, err := c.svc.PutRule(input)
+	if err != nil {
+		return "", awserrors.Wrap(err)
+	}
+
+	return aws.StringValue(resp.RuleArn), nil
+}
+
+// PutTargets creates or updates ECS task targets of a rule
+func (c *CloudWatchEvents) PutTargets(rule string, targets []Target) error {
+	var input []*cloudwatchevents.Target
+
+	for _, target := range targets {
+		input = append(input, target.toSDK())
+	}
+
+	return c.putTargets(rule, input)
+}
+
+func (c *CloudWatchEvents) putTargets(rule string, targets []*cloudwatchevents.Target) error {
+	resp, err := c.svc.PutTargets(
+		&cloudwatchevents.PutTargetsInput{
+			Rule:    aws.String(rule),
+			Targets: targets,
+		},
+	)
+
+	if err != nil {
+		return awserrors.Wrap(err)
+	}
+
+	if aws.Int64Value(resp.FailedEntryCount) != 0 && len(resp.FailedEntries) != 0 {
+		var failures []string
+
+		for _, entry := range resp.FailedEntries {
+			failures = append(failures, fmt.Sprintf("TargetId: %s; ErrorCode: %s; ErrorMessage: %s", aws.StringValue(entry.TargetId), aws.StringValue(entry.ErrorCode), aws.StringValue(entry.ErrorMessage)))
+		}
+
+		return awserrors.New(awserrors.Unknown, "%s", strings.Join(failures, "\n"))
+	}
+
+	return nil
+}
+
+// ListRules returns all rules, optionally filtered by a name prefix
+func (c *CloudWatchEvents) ListRules(namePrefix string) ([]Rule, error) {
+	var rules []Rule
+
+	input := &cloudwatchevents.ListRulesInput{}
+	if namePrefix != "" {
+		input.SetNamePrefix(namePrefix)
+	}
+
+	for {
+		resp, err := c.svc.ListRules(input)
+		if err != nil {
+			return rules, awserrors.Wrap(err)
+		}
+
+		for _, rule := range resp.Rules {
+			rules = append(rules, Rule{
+				Arn:                aws.StringValue(rule.Arn),
+				Description:        aws.StringValue(rule.Description),
+				Name:               aws.StringValue(rule.Name),
+				ScheduleExpression: aws.StringValue(rule.ScheduleExpression),
+				State:              aws.StringValue(rule.State),
+			})
+		}
+
+		if resp.NextToken == nil {
+			break
+		}
+
+		input.SetNextToken(aws.StringValue(resp.NextToken))
+	}
+
+	return rules, nil
+}
+
+// DescribeRule returns a rule
+func (c *CloudWatchEvents) DescribeRule(name string) (Rule, error) {
+	resp, err := c.svc.DescribeRule(
+		&cloudwatchevents.DescribeRuleInput{
+			Name: aws.String(name),
+		},
+	)
+
+	if err != nil {
+		return Rule{}, awserrors.Wrap(err)
+	}
+
+	return Rule{
+		Arn:                aws.StringValue(resp.Arn),
+		Description:        aws.StringValue(resp.Description),
+		Name:               aws.StringValue(resp.Name),
+		ScheduleExpression: aws.StringValue(resp.ScheduleExpression),
+		State:              aws.StringValue(resp.State),
+	}, nil
+}
+
+// RuleExists returns whether a rule exists
+func (c *CloudWatchEvents) RuleExists(name string) (bool, error) {
+	_, err := c.DescribeRule(name)
+
+	if awserrors.IsNotFound(err) {
+		return false, nil
+	}
+
+	return err == nil, err
+}
+
+// ListTargets returns the ECS task targets of a rule
+func (c *CloudWatchEvents) ListTargets(rule string) ([]Target, error) {
+	var targets []Target
+
+	sdkTargets, err := c.listTargets(rule)
+	if err != nil {
+		return targets, err
+	}
+
+	for _, target := range sdkTargets {
+		if target.EcsParameters != nil {
+			targets = append(targets, newTarget(target))
+		}
+	}
+
+	return targets, nil
+}
+
+func (c *CloudWatchEvents) listTargets(rule string) ([]*cloudwatchevents.Target, error) {
+	var targets []*cloudwatchevents.Target
+
+	input := &cloudwatchevents.ListTargetsByRuleInput{
+		Rule: aws.String(rule),
+	}
+
+	for {
+		resp, err := c.svc.ListTargetsByRule(input)
+		if err != nil {
+			return targets, awserrors.Wrap(err)
+		}
+
+		targets = append(targets, resp.Targets...)
+
+		if resp.NextToken == nil {
+			break
+		}
+
+		input.SetNextToken(aws.StringValue(resp.NextToken))
+	}
+
+	return targets, nil
+}
+
+// EnableRule enables a rule
+func (c *CloudWatchEvents) EnableRule(name string) error {
+	_, err := c.svc.EnableRule(
+		&cloudwatchevents.EnableRuleInput{
+			Name: aws.String(name),
+		},
+	)
+
+	return awserrors.Wrap(err)
+}
+
+// DisableRule disables a rule
+func (c *CloudWatchEvents) DisableRule(name string) error {
+	_, err := c.svc.DisableRule(
+		&cloudwatchevents.DisableRuleInput{
+			Name: aws.String(name),
+		},
+	)
+
+	return awserrors.Wrap(err)
+}
+
+// DeleteRule removes all targets from a rule and then deletes it
+func (c *CloudWatchEvents) DeleteRule(name string) error {
+	var ids []*string
+
+	targets, err := c.listTargets(name)
+	if err != nil {
+		return fmt.Errorf("could not list targets of rule %s: %w", name, err)
+	}
+
+	for _, target := range targets {
+		ids = append(ids, target.Id)
+	}
+
+	if len(ids) > 0 {
+		resp, err := c.svc.RemoveTargets(
+			&cloudwatchevents.RemoveTargetsInput{
+				Rule: aws.String(name),
+				Ids:  ids,
+			},
+		)
+
+		if err != nil {
+			return fmt.Errorf("could not remove targets from rule %s: %w", name, awserrors.Wrap(err))
+		}
+
+		if aws.Int64Value(resp.FailedEntryCount) != 0 {
+			return awserrors.New(awserrors.Unknown, "could not remove all targets from rule %s", name)
+		}
+	}
+
+	_, err = c.svc.DeleteRule(
+		&cloudwatchevents.DeleteRuleInput{
+			Name: aws.String(name),
+		},
+	)
+
+	return awserrors.Wrap(err)
+}
+
+func newTarget(t *cloudwatchevents.Target) Target {
+	target := Target{
+		Id:                aws.StringValue(t.Id),
+		ClusterArn:        aws.StringValue(t.Arn),
+		RoleArn:           aws.StringValue(t.RoleArn),
+		Input:             aws.StringValue(t.Input),
+		TaskDefinitionArn: aws.StringValue(t.EcsParameters.TaskDefinitionArn),
+		TaskCount:         aws.Int64Value(t.EcsParameters.TaskCount),
+		LaunchType:        aws.StringValue(t.EcsParameters.LaunchType),
+		PlatformVersion:   aws.StringValue(t.EcsParameters.PlatformVersion),
+		Group:             aws.StringValue(t.EcsParameters.Group),
+	}
+
+	for _, item := range t.EcsParameters.CapacityProviderStrategy {
+		target.CapacityProviderStrategy = append(target.CapacityProviderStrategy,
+			CapacityProviderStrategyItem{
+				CapacityProvider: aws.StringValue(item.CapacityProvider),
+				Base:             aws.Int64Value(item.Base),
+				Weight:           aws.Int64Value(item.Weight),
+			},
+		)
+	}
+
+	if nc := t.EcsParameters.NetworkConfiguration; nc != nil && nc.AwsvpcConfiguration != nil {
+		target.SubnetIds = aws.StringValueSlice(nc.AwsvpcConfiguration.Subnets)
+		target.SecurityGroupIds = aws.StringValueSlice(nc.AwsvpcConfiguration.SecurityGroups)
+		target.AssignPublicIp = aws.StringValue(nc.AwsvpcConfiguration.AssignPublicIp)
+	}
+
+	return target
+}
+
+func (t Target) toSDK() *cloudwatchevents.Target {
+	ecsParameters := &cloudwatchevents.EcsParameters{
+		TaskDefinitionArn: aws.String(t.TaskDefinitionArn),
+		TaskCount:         aws.Int64(t.TaskCount),
+		NetworkConfiguration: &cloudwatchevents.NetworkConfiguration{
+			AwsvpcConfiguration: &cloudwatchevents.AwsVpcConfiguration{
+				AssignPublicIp: aws.String(t.AssignPublicIp),
+				Subnets:        aws.StringSlice(t.SubnetIds),
+				SecurityGroups: aws.StringSlice(t.SecurityGroupIds),
+			},
+		},
+	}
+
+	if t.LaunchType != "" {
+		ecsParameters.SetLaunchType(t.LaunchType)
+	}
+
+	for _, item := range t.CapacityProviderStrategy {
+		ecsParameters.CapacityProviderStrategy = append(ecsParameters.CapacityProviderStrategy,
+			&cloudwatchevents.CapacityProviderStrategyItem{
+				CapacityProvider: aws.String(item.CapacityProvider),
+				Base:             aws.Int64(item.Base),
+				Weight:           aws.Int64(item.Weight),
+			},
+		)
+	}
+
+	if t.PlatformVersion != "" {
+		ecsParameters.SetPlatformVersion(t.PlatformVersion)
+	}
+
+	if t.Group != "" {
+		ecsParameters.SetGroup(t.Group)
+	}
+
+	target := &cloudwatchevents.Target{
+		Id:            aws.String(t.Id),
+		Arn:           aws.String(t.ClusterArn),
+		RoleArn:       aws.String(t.RoleArn),
+		EcsParameters: ecsParameters,
+	}
+
+	if t.Input != "" {
+		target.SetInput(t.Input)
+	}
+
+	return target
+}
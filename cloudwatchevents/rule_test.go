@@ -0,0 +1,148 @@
+package cloudwatchevents
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/cloudwatchevents"
+	"github.com/golang/mock/gomock"
+	"github.com/turnerlabs/fargate/awserrors"
+	"github.com/turnerlabs/fargate/cloudwatchevents/mock/sdk"
+)
+
+func TestRuleExistsNotFound(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mockCWEClient := sdk.NewMockCloudWatchEventsAPI(mockCtrl)
+	cwe := NewWithClient(mockCWEClient)
+
+	mockCWEClient.EXPECT().DescribeRule(gomock.Any()).Return(
+		nil,
+		awserr.New(cloudwatchevents.ErrCodeResourceNotFoundException, "Rule nightly does not exist.", nil),
+	)
+
+	exists, err := cwe.RuleExists("nightly")
+
+	if err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+
+	if exists {
+		t.Errorf("Expected rule not to exist")
+	}
+}
+
+func TestPutRuleKeepsState(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mockCWEClient := sdk.NewMockCloudWatchEventsAPI(mockCtrl)
+	cwe := NewWithClient(mockCWEClient)
+
+	mockCWEClient.EXPECT().DescribeRule(gomock.Any()).Return(
+		&cloudwatchevents.DescribeRuleOutput{
+			Name:  aws.String("nightly"),
+			State: aws.String(cloudwatchevents.RuleStateDisabled),
+		},
+		nil,
+	)
+
+	mockCWEClient.EXPECT().PutRule(
+		&cloudwatchevents.PutRuleInput{
+			Name:               aws.String("nightly"),
+			ScheduleExpression: aws.String("rate(1 day)"),
+			State:              aws.String(cloudwatchevents.RuleStateDisabled),
+		},
+	).Return(&cloudwatchevents.PutRuleOutput{RuleArn: aws.String("arn:aws:events:us-east-1:123456789012:rule/nightly")}, nil)
+
+	if _, err := cwe.PutRule(&PutRuleInput{Name: "nightly", ScheduleExpression: "rate(1 day)"}); err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+}
+
+func TestPutRuleNewRuleEnabled(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mockCWEClient := sdk.NewMockCloudWatchEventsAPI(mockCtrl)
+	cwe := NewWithClient(mockCWEClient)
+
+	mockCWEClient.EXPECT().DescribeRule(gomock.Any()).Return(
+		nil,
+		awserr.New(cloudwatchevents.ErrCodeResourceNotFoundException, "Rule nightly does not exist.", nil),
+	)
+
+	mockCWEClient.EXPECT().PutRule(
+		&cloudwatchevents.PutRuleInput{
+			Name:               aws.String("nightly"),
+			ScheduleExpression: aws.String("rate(1 day)"),
+			State:              aws.String(cloudwatchevents.RuleStateEnabled),
+		},
+	).Return(&cloudwatchevents.PutRuleOutput{RuleArn: aws.String("arn:aws:events:us-east-1:123456789012:rule/nightly")}, nil)
+
+	if _, err := cwe.PutRule(&PutRuleInput{Name: "nightly", ScheduleExpression: "rate(1 day)"}); err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+}
+
+func TestUpdateTargetTaskDefinitions(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	taskDefinitionArn := "arn:aws:ecs:us-east-1:123456789012:task-definition/nightly:2"
+	target := &cloudwatchevents.Target{
+		Id:  aws.String("nightly"),
+		Arn: aws.String("arn:aws:ecs:us-east-1:123456789012:cluster/my-cluster"),
+		EcsParameters: &cloudwatchevents.EcsParameters{
+			TaskCount:         aws.Int64(1),
+			TaskDefinitionArn: aws.String("arn:aws:ecs:us-east-1:123456789012:task-definition/nightly:1"),
+		},
+	}
+
+	mockCWEClient := sdk.NewMockCloudWatchEventsAPI(mockCtrl)
+	cwe := NewWithClient(mockCWEClient)
+
+	mockCWEClient.EXPECT().ListTargetsByRule(gomock.Any()).Return(
+		&cloudwatchevents.ListTargetsByRuleOutput{Targets: []*cloudwatchevents.Target{target}},
+		nil,
+	)
+	mockCWEClient.EXPECT().PutTargets(gomock.Any()).DoAndReturn(
+		func(input *cloudwatchevents.PutTargetsInput) (*cloudwatchevents.PutTargetsOutput, error) {
+			if len(input.Targets) != 1 {
+				t.Fatalf("Expected 1 target, got %d", len(input.Targets))
+			}
+
+			if arn := aws.StringValue(input.Targets[0].EcsParameters.TaskDefinitionArn); arn != taskDefinitionArn {
+				t.Errorf("Expected %s, got %s", taskDefinitionArn, arn)
+			}
+
+			if count := aws.Int64Value(input.Targets[0].EcsParameters.TaskCount); count != 1 {
+				t.Errorf("Expected task count 1, got %d", count)
+			}
+
+			return &cloudwatchevents.PutTargetsOutput{}, nil
+		},
+	)
+
+	if err := cwe.UpdateTargetTaskDefinitions("nightly", map[string]string{"nightly": taskDefinitionArn}); err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+}
+
+func TestUpdateTargetTaskDefinitionsMissingTarget(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mockCWEClient := sdk.NewMockCloudWatchEventsAPI(mockCtrl)
+	cwe := NewWithClient(mockCWEClient)
+
+	mockCWEClient.EXPECT().ListTargetsByRule(gomock.Any()).Return(&cloudwatchevents.ListTargetsByRuleOutput{}, nil)
+
+	err := cwe.UpdateTargetTaskDefinitions("nightly", map[string]string{"nightly": "arn"})
+
+	if !awserrors.IsNotFound(err) {
+		t.Errorf("Expected a not found error, got %v", err)
+	}
+}
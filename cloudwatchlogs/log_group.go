@@ -7,15 +7,23 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	awscwl "github.com/aws/aws-sdk-go/service/cloudwatchlogs"
-	"github.com/turnerlabs/fargate/console"
+	"github.com/turnerlabs/fargate/awserrors"
 )
 
 type GetLogsInput struct {
-	Filter         string
-	LogGroupName   string
-	LogStreamNames []string
-	EndTime        time.Time
-	StartTime      time.Time
+	Filter              string
+	LogGroupName        string
+	LogStreamNames      []string
+	LogStreamNamePrefix string
+	EndTime             time.Time
+	StartTime           time.Time
+}
+
+type LogGroup struct {
+	Name            string
+	CreationTime    time.Time
+	RetentionInDays int64
+	StoredBytes     int64
 }
 
 type LogLine struct {
@@ -25,7 +33,7 @@ type LogLine struct {
 	Timestamp     time.Time
 }
 
-func (cwl *CloudWatchLogs) CreateLogGroup(logGroupName string, a ...interface{}) string {
+func (cwl *CloudWatchLogs) CreateLogGroup(logGroupName string, a ...interface{}) (string, error) {
 	formattedLogGroupName := fmt.Sprintf(logGroupName, a...)
 	_, err := cwl.svc.CreateLogGroup(
 		&awscwl.CreateLogGroupInput{
@@ -37,17 +45,67 @@ func (cwl *CloudWatchLogs) CreateLogGroup(logGroupName string, a ...interface{})
 		if awsErr, ok := err.(awserr.Error); ok {
 			switch awsErr.Code() {
 			case awscwl.ErrCodeResourceAlreadyExistsException:
-				return formattedLogGroupName
+				return formattedLogGroupName, nil
 			default:
-				console.ErrorExit(awsErr, "Could not create Cloudwatch Logs log group")
+				return formattedLogGroupName, awserrors.Wrap(awsErr)
 			}
 		}
 	}
 
-	return formattedLogGroupName
+	return formattedLogGroupName, nil
+}
+
+// DescribeLogGroup returns a log group
+func (cwl *CloudWatchLogs) DescribeLogGroup(logGroupName string) (LogGroup, error) {
+	resp, err := cwl.svc.DescribeLogGroups(
+		&awscwl.DescribeLogGroupsInput{
+			LogGroupNamePrefix: aws.String(logGroupName),
+		},
+	)
+
+	if err != nil {
+		return LogGroup{}, awserrors.Wrap(err)
+	}
+
+	for _, logGroup := range resp.LogGroups {
+		if aws.StringValue(logGroup.LogGroupName) == logGroupName {
+			return LogGroup{
+				Name:            logGroupName,
+				CreationTime:    millisecondsToTime(aws.Int64Value(logGroup.CreationTime)),
+				RetentionInDays: aws.Int64Value(logGroup.RetentionInDays),
+				StoredBytes:     aws.Int64Value(logGroup.StoredBytes),
+			}, nil
+		}
+	}
+
+	return LogGroup{}, awserrors.New(awserrors.NotFound, "log group %s not found", logGroupName)
 }
 
-func (cwl *CloudWatchLogs) GetLogs(i *GetLogsInput) []LogLine {
+// SetRetentionPolicy expires the events of a log group after a number of days
+func (cwl *CloudWatchLogs) SetRetentionPolicy(logGroupName string, days int64) error {
+	_, err := cwl.svc.PutRetentionPolicy(
+		&awscwl.PutRetentionPolicyInput{
+			LogGroupName:    aws.String(logGroupName),
+			RetentionInDays: aws.Int64(days),
+		},
+	)
+
+	return awserrors.Wrap(err)
+}
+
+// DeleteRetentionPolicy keeps the events of a log group forever
+func (cwl *CloudWatchLogs) DeleteRetentionPolicy(logGroupName string) error {
+	_, err := cwl.svc.DeleteRetentionPolicy(
+		&awscwl.DeleteRetentionPolicyInput{
+			LogGroupName: aws.String(logGroupName),
+		},
+	)
+
+	return awserrors.Wrap(err)
+}
+
+// FilterLogEvents returns the events of a log group matching the input
+func (cwl *CloudWatchLogs) FilterLogEvents(i *GetLogsInput) ([]LogLine, error) {
 	var logLines []LogLine
 
 	input := &awscwl.FilterLogEventsInput{
@@ -69,6 +127,8 @@ func (cwl *CloudWatchLogs) GetLogs(i *GetLogsInput) []LogLine {
 
 	if len(i.LogStreamNames) > 0 {
 		input.SetLogStreamNames(aws.StringSlice(i.LogStreamNames))
+	} else if i.LogStreamNamePrefix != "" {
+		input.SetLogStreamNamePrefix(i.LogStreamNamePrefix)
 	}
 
 	err := cwl.svc.FilterLogEventsPages(
@@ -90,9 +150,5 @@ func (cwl *CloudWatchLogs) GetLogs(i *GetLogsInput) []LogLine {
 		},
 	)
 
-	if err != nil {
-		console.ErrorExit(err, "Could not get logs for: " + i.LogGroupName)
-	}
-
-	return logLines
+	return logLines, awserrors.Wrap(err)
 }
@@ -0,0 +1,105 @@
+package cloudwatchlogs
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	awscwl "github.com/aws/aws-sdk-go/service/cloudwatchlogs"
+	"github.com/golang/mock/gomock"
+	"github.com/turnerlabs/fargate/awserrors"
+	"github.com/turnerlabs/fargate/cloudwatchlogs/mock/sdk"
+)
+
+func TestDescribeLogGroup(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mockCWLClient := sdk.NewMockCloudWatchLogsAPI(mockCtrl)
+	cwl := NewWithClient(mockCWLClient)
+
+	mockCWLClient.EXPECT().DescribeLogGroups(
+		&awscwl.DescribeLogGroupsInput{
+			LogGroupNamePrefix: aws.String("/fargate/service/web"),
+		},
+	).Return(
+		&awscwl.DescribeLogGroupsOutput{
+			LogGroups: []*awscwl.LogGroup{
+				&awscwl.LogGroup{LogGroupName: aws.String("/fargate/service/web-worker")},
+				&awscwl.LogGroup{LogGroupName: aws.String("/fargate/service/web"), RetentionInDays: aws.Int64(30)},
+			},
+		},
+		nil,
+	)
+
+	logGroup, err := cwl.DescribeLogGroup("/fargate/service/web")
+
+	if err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+
+	if logGroup.RetentionInDays != 30 {
+		t.Errorf("Expected retention 30, got %d", logGroup.RetentionInDays)
+	}
+}
+
+func TestDescribeLogGroupNotFound(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mockCWLClient := sdk.NewMockCloudWatchLogsAPI(mockCtrl)
+	cwl := NewWithClient(mockCWLClient)
+
+	mockCWLClient.EXPECT().DescribeLogGroups(gomock.Any()).Return(&awscwl.DescribeLogGroupsOutput{}, nil)
+
+	_, err := cwl.DescribeLogGroup("/fargate/service/web")
+
+	if !awserrors.IsNotFound(err) {
+		t.Errorf("Expected a not found error, got %v", err)
+	}
+}
+
+func TestFilterLogEvents(t *testing.T) {
+	resp := &awscwl.FilterLogEventsOutput{
+		Events: []*awscwl.FilteredLogEvent{
+			&awscwl.FilteredLogEvent{
+				EventId:       aws.String("1"),
+				LogStreamName: aws.String("fargate/web/abc"),
+				Message:       aws.String("hello"),
+				Timestamp:     aws.Int64(1500000000000),
+			},
+		},
+	}
+
+	cwl := NewWithClient(sdk.MockFilterLogEventsPagesClient{Resp: resp})
+
+	logLines, err := cwl.FilterLogEvents(&GetLogsInput{LogGroupName: "/fargate/service/web"})
+
+	if err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+
+	if len(logLines) != 1 {
+		t.Fatalf("Expected 1 log line, got %d", len(logLines))
+	}
+
+	if logLines[0].Message != "hello" {
+		t.Errorf("Expected hello, got %s", logLines[0].Message)
+	}
+
+	if logLines[0].Timestamp.Unix() != 1500000000 {
+		t.Errorf("Expected 1500000000, got %d", logLines[0].Timestamp.Unix())
+	}
+}
+
+func TestFilterLogEventsThrottled(t *testing.T) {
+	cwl := NewWithClient(sdk.MockFilterLogEventsPagesClient{
+		Error: awserr.New("ThrottlingException", "Rate exceeded", nil),
+	})
+
+	_, err := cwl.FilterLogEvents(&GetLogsInput{LogGroupName: "/fargate/service/web"})
+
+	if !awserrors.IsThrottled(err) {
+		t.Errorf("Expected a throttled error, got %v", err)
+	}
+}
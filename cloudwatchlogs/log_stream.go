@@ -0,0 +1,126 @@
+package cloudwatchlogs
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awscwl "github.com/aws/aws-sdk-go/service/cloudwatchlogs"
+	"github.com/turnerlabs/fargate/awserrors"
+)
+
+type LogStream struct {
+	Name           string
+	FirstEventTime time.Time
+	LastEventTime  time.Time
+}
+
+type GetLogEventsInput struct {
+	LogGroupName  string
+	LogStreamName string
+	StartTime     time.Time
+	EndTime       time.Time
+	NextToken     string
+}
+
+type GetLogEventsOutput struct {
+	LogLines []LogLine
+	// empty when the end of the stream has been reached
+	NextToken string
+}
+
+// DescribeLogStreams returns the log streams of a log group whose names start
+// with prefix
+func (cwl *CloudWatchLogs) DescribeLogStreams(logGroupName, prefix string) ([]LogStream, error) {
+	var logStreams []LogStream
+
+	input := &awscwl.DescribeLogStreamsInput{
+		LogGroupName: aws.String(logGroupName),
+	}
+
+	if prefix != "" {
+		input.SetLogStreamNamePrefix(prefix)
+	}
+
+	err := cwl.svc.DescribeLogStreamsPages(
+		input,
+		func(resp *awscwl.DescribeLogStreamsOutput, lastPage bool) bool {
+			for _, stream := range resp.LogStreams {
+				lastEventTime := aws.Int64Value(stream.LastEventTimestamp)
+
+				// the last event timestamp is updated lazily, the last ingestion time is not
+				if lastIngestionTime := aws.Int64Value(stream.LastIngestionTime); lastIngestionTime > lastEventTime {
+					lastEventTime = lastIngestionTime
+				}
+
+				logStreams = append(logStreams,
+					LogStream{
+						Name:           aws.StringValue(stream.LogStreamName),
+						FirstEventTime: millisecondsToTime(aws.Int64Value(stream.FirstEventTimestamp)),
+						LastEventTime:  millisecondsToTime(lastEventTime),
+					},
+				)
+			}
+
+			return true
+		},
+	)
+
+	return logStreams, awserrors.Wrap(err)
+}
+
+// GetLogEventsPage returns a page of events of a log stream, oldest first
+func (cwl *CloudWatchLogs) GetLogEventsPage(i *GetLogEventsInput) (GetLogEventsOutput, error) {
+	var output GetLogEventsOutput
+
+	input := &awscwl.GetLogEventsInput{
+		LogGroupName:  aws.String(i.LogGroupName),
+		LogStreamName: aws.String(i.LogStreamName),
+		StartFromHead: aws.Bool(true),
+	}
+
+	if i.NextToken != "" {
+		input.SetNextToken(i.NextToken)
+	} else {
+		if !i.StartTime.IsZero() {
+			input.SetStartTime(timeToMilliseconds(i.StartTime))
+		}
+
+		if !i.EndTime.IsZero() {
+			input.SetEndTime(timeToMilliseconds(i.EndTime))
+		}
+	}
+
+	resp, err := cwl.svc.GetLogEvents(input)
+	if err != nil {
+		return output, awserrors.Wrap(err)
+	}
+
+	for _, event := range resp.Events {
+		output.LogLines = append(output.LogLines,
+			LogLine{
+				LogStreamName: i.LogStreamName,
+				Message:       aws.StringValue(event.Message),
+				Timestamp:     millisecondsToTime(aws.Int64Value(event.Timestamp)),
+			},
+		)
+	}
+
+	// the same token is returned once the end of the stream has been reached
+	if token := aws.StringValue(resp.NextForwardToken); token != i.NextToken {
+		output.NextToken = token
+	}
+
+	return output, nil
+}
+
+func millisecondsToTime(ms int64) time.Time {
+	if ms == 0 {
+		return time.Time{}
+	}
+
+	return time.Unix(0, ms*int64(time.Millisecond))
+}
+
+func timeToMilliseconds(t time.Time) int64 {
+	return t.UTC().UnixNano() / int64(time.Millisecond)
+}
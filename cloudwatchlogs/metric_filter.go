@@ -0,0 +1,79 @@
+package cloudwatchlogs
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	awscwl "github.com/aws/aws-sdk-go/service/cloudwatchlogs"
+	"github.com/turnerlabs/fargate/awserrors"
+)
+
+// MetricFilter counts the events of a log group matching a pattern as a
+// CloudWatch metric. The metric is 0 when no events match.
+type MetricFilter struct {
+	Name            string
+	FilterPattern   string
+	MetricNamespace string
+	MetricName      string
+}
+
+// PutMetricFilter creates or updates a metric filter of a log group
+func (cwl *CloudWatchLogs) PutMetricFilter(logGroupName string, filter MetricFilter) error {
+	_, err := cwl.svc.PutMetricFilter(
+		&awscwl.PutMetricFilterInput{
+			LogGroupName:  aws.String(logGroupName),
+			FilterName:    aws.String(filter.Name),
+			FilterPattern: aws.String(filter.FilterPattern),
+			MetricTransformations: []*awscwl.MetricTransformation{
+				&awscwl.MetricTransformation{
+					MetricNamespace: aws.String(filter.MetricNamespace),
+					MetricName:      aws.String(filter.MetricName),
+					MetricValue:     aws.String("1"),
+					DefaultValue:    aws.Float64(0),
+				},
+			},
+		},
+	)
+
+	return awserrors.Wrap(err)
+}
+
+// DeleteMetricFilter removes a metric filter from a log group
+func (cwl *CloudWatchLogs) DeleteMetricFilter(logGroupName, filterName string) error {
+	_, err := cwl.svc.DeleteMetricFilter(
+		&awscwl.DeleteMetricFilterInput{
+			LogGroupName: aws.String(logGroupName),
+			FilterName:   aws.String(filterName),
+		},
+	)
+
+	return awserrors.Wrap(err)
+}
+
+// ListMetricFilters returns the metric filters of a log group
+func (cwl *CloudWatchLogs) ListMetricFilters(logGroupName string) ([]MetricFilter, error) {
+	var filters []MetricFilter
+
+	err := cwl.svc.DescribeMetricFiltersPages(
+		&awscwl.DescribeMetricFiltersInput{
+			LogGroupName: aws.String(logGroupName),
+		},
+		func(resp *awscwl.DescribeMetricFiltersOutput, lastPage bool) bool {
+			for _, filter := range resp.MetricFilters {
+				metricFilter := MetricFilter{
+					Name:          aws.StringValue(filter.FilterName),
+					FilterPattern: aws.StringValue(filter.FilterPattern),
+				}
+
+				if len(filter.MetricTransformations) > 0 {
+					metricFilter.MetricNamespace = aws.StringValue(filter.MetricTransformations[0].MetricNamespace)
+					metricFilter.MetricName = aws.StringValue(filter.MetricTransformations[0].MetricName)
+				}
+
+				filters = append(filters, metricFilter)
+			}
+
+			return true
+		},
+	)
+
+	return filters, awserrors.Wrap(err)
+}
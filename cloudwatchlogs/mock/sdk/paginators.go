@@ -0,0 +1,22 @@
+package sdk
+
+import (
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs/cloudwatchlogsiface"
+)
+
+type MockFilterLogEventsPagesClient struct {
+	cloudwatchlogsiface.CloudWatchLogsAPI
+	Resp  *cloudwatchlogs.FilterLogEventsOutput
+	Error error
+}
+
+func (m MockFilterLogEventsPagesClient) FilterLogEventsPages(in *cloudwatchlogs.FilterLogEventsInput, fn func(*cloudwatchlogs.FilterLogEventsOutput, bool) bool) error {
+	if m.Error != nil {
+		return m.Error
+	}
+
+	fn(m.Resp, true)
+
+	return nil
+}
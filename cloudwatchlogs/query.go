@@ -0,0 +1,130 @@
+package cloudwatchlogs
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awscwl "github.com/aws/aws-sdk-go/service/cloudwatchlogs"
+	"github.com/turnerlabs/fargate/awserrors"
+)
+
+const queryPollInterval = time.Second
+
+// fields returned by Logs Insights that only identify a log event
+const fieldPointer = "@ptr"
+
+type QueryInput struct {
+	LogGroupNames []string
+	QueryString   string
+	StartTime     time.Time
+	EndTime       time.Time
+	Limit         int64
+}
+
+type QueryResults struct {
+	Fields         []string
+	Rows           []map[string]string
+	Status         string
+	RecordsMatched float64
+	RecordsScanned float64
+	BytesScanned   float64
+}
+
+// Query runs a CloudWatch Logs Insights query and polls until it has finished
+func (cwl *CloudWatchLogs) Query(i *QueryInput) (QueryResults, error) {
+	queryId, err := cwl.StartQuery(i)
+	if err != nil {
+		return QueryResults{}, err
+	}
+
+	for {
+		results, err := cwl.GetQueryResults(queryId)
+		if err != nil {
+			return results, err
+		}
+
+		switch results.Status {
+		case awscwl.QueryStatusComplete:
+			return results, nil
+		case awscwl.QueryStatusFailed, awscwl.QueryStatusCancelled, awscwl.QueryStatusTimeout:
+			return results, awserrors.New(awserrors.Unknown, "query %s", results.Status)
+		}
+
+		time.Sleep(queryPollInterval)
+	}
+}
+
+// StartQuery starts a CloudWatch Logs Insights query and returns its id
+func (cwl *CloudWatchLogs) StartQuery(i *QueryInput) (string, error) {
+	input := &awscwl.StartQueryInput{
+		LogGroupNames: aws.StringSlice(i.LogGroupNames),
+		QueryString:   aws.String(i.QueryString),
+		StartTime:     aws.Int64(i.StartTime.Unix()),
+		EndTime:       aws.Int64(i.EndTime.Unix()),
+	}
+
+	if i.Limit > 0 {
+		input.SetLimit(i.Limit)
+	}
+
+	resp, err := cwl.svc.StartQuery(input)
+
+	if err != nil {
+		return "", awserrors.Wrap(err)
+	}
+
+	return aws.StringValue(resp.QueryId), nil
+}
+
+// GetQueryResults returns the (possibly partial) results of a query
+func (cwl *CloudWatchLogs) GetQueryResults(queryId string) (QueryResults, error) {
+	resp, err := cwl.svc.GetQueryResults(
+		&awscwl.GetQueryResultsInput{
+			QueryId: aws.String(queryId),
+		},
+	)
+
+	if err != nil {
+		return QueryResults{}, awserrors.Wrap(err)
+	}
+
+	results := newQueryResults(resp.Results)
+	results.Status = aws.StringValue(resp.Status)
+
+	if resp.Statistics != nil {
+		results.RecordsMatched = aws.Float64Value(resp.Statistics.RecordsMatched)
+		results.RecordsScanned = aws.Float64Value(resp.Statistics.RecordsScanned)
+		results.BytesScanned = aws.Float64Value(resp.Statistics.BytesScanned)
+	}
+
+	return results, nil
+}
+
+// converts result rows into maps, keeping the order in which fields first appear
+func newQueryResults(rows [][]*awscwl.ResultField) QueryResults {
+	results := QueryResults{}
+	seen := make(map[string]bool)
+
+	for _, row := range rows {
+		values := make(map[string]string)
+
+		for _, field := range row {
+			name := aws.StringValue(field.Field)
+
+			if name == fieldPointer {
+				continue
+			}
+
+			if !seen[name] {
+				seen[name] = true
+				results.Fields = append(results.Fields, name)
+			}
+
+			values[name] = aws.StringValue(field.Value)
+		}
+
+		results.Rows = append(results.Rows, values)
+	}
+
+	return results
+}
@@ -0,0 +1,39 @@
+package cloudwatchlogs
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awscwl "github.com/aws/aws-sdk-go/service/cloudwatchlogs"
+)
+
+func TestNewQueryResults(t *testing.T) {
+	rows := [][]*awscwl.ResultField{
+		[]*awscwl.ResultField{
+			&awscwl.ResultField{Field: aws.String("bin(5m)"), Value: aws.String("2020-01-01 06:00:00.000")},
+			&awscwl.ResultField{Field: aws.String("count(*)"), Value: aws.String("42")},
+		},
+		[]*awscwl.ResultField{
+			&awscwl.ResultField{Field: aws.String("@ptr"), Value: aws.String("abc")},
+			&awscwl.ResultField{Field: aws.String("count(*)"), Value: aws.String("7")},
+			&awscwl.ResultField{Field: aws.String("level"), Value: aws.String("error")},
+		},
+	}
+
+	results := newQueryResults(rows)
+
+	expected := []string{"bin(5m)", "count(*)", "level"}
+	if len(results.Fields) != len(expected) {
+		t.Fatalf("Expected %v, got %v", expected, results.Fields)
+	}
+
+	for i := range expected {
+		if results.Fields[i] != expected[i] {
+			t.Errorf("Expected %v, got %v", expected, results.Fields)
+		}
+	}
+
+	if len(results.Rows) != 2 || results.Rows[1]["count(*)"] != "7" || results.Rows[0]["level"] != "" {
+		t.Errorf("Unexpected rows %v", results.Rows)
+	}
+}
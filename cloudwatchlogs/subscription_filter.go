@@ -0,0 +1,73 @@
+package cloudwatchlogs
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	awscwl "github.com/aws/aws-sdk-go/service/cloudwatchlogs"
+	"github.com/turnerlabs/fargate/awserrors"
+)
+
+// SubscriptionFilter forwards the events of a log group to a Lambda function
+// or Kinesis stream
+type SubscriptionFilter struct {
+	Name           string
+	FilterPattern  string
+	DestinationArn string
+	RoleArn        string
+}
+
+// PutSubscriptionFilter creates or updates a subscription filter of a log group
+func (cwl *CloudWatchLogs) PutSubscriptionFilter(logGroupName string, filter SubscriptionFilter) error {
+	input := &awscwl.PutSubscriptionFilterInput{
+		LogGroupName:   aws.String(logGroupName),
+		FilterName:     aws.String(filter.Name),
+		FilterPattern:  aws.String(filter.FilterPattern),
+		DestinationArn: aws.String(filter.DestinationArn),
+	}
+
+	if filter.RoleArn != "" {
+		input.SetRoleArn(filter.RoleArn)
+	}
+
+	_, err := cwl.svc.PutSubscriptionFilter(input)
+
+	return awserrors.Wrap(err)
+}
+
+// DeleteSubscriptionFilter removes a subscription filter from a log group
+func (cwl *CloudWatchLogs) DeleteSubscriptionFilter(logGroupName, filterName string) error {
+	_, err := cwl.svc.DeleteSubscriptionFilter(
+		&awscwl.DeleteSubscriptionFilterInput{
+			LogGroupName: aws.String(logGroupName),
+			FilterName:   aws.String(filterName),
+		},
+	)
+
+	return awserrors.Wrap(err)
+}
+
+// ListSubscriptionFilters returns the subscription filters of a log group
+func (cwl *CloudWatchLogs) ListSubscriptionFilters(logGroupName string) ([]SubscriptionFilter, error) {
+	var filters []SubscriptionFilter
+
+	err := cwl.svc.DescribeSubscriptionFiltersPages(
+		&awscwl.DescribeSubscriptionFiltersInput{
+			LogGroupName: aws.String(logGroupName),
+		},
+		func(resp *awscwl.DescribeSubscriptionFiltersOutput, lastPage bool) bool {
+			for _, filter := range resp.SubscriptionFilters {
+				filters = append(filters,
+					SubscriptionFilter{
+						Name:           aws.StringValue(filter.FilterName),
+						FilterPattern:  aws.StringValue(filter.FilterPattern),
+						DestinationArn: aws.StringValue(filter.DestinationArn),
+						RoleArn:        aws.StringValue(filter.RoleArn),
+					},
+				)
+			}
+
+			return true
+		},
+	)
+
+	return filters, awserrors.Wrap(err)
+}
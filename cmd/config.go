@@ -3,6 +3,8 @@ package cmd
 import (
 	"fmt"
 	"os"
+	"sort"
+	"strings"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
@@ -16,8 +18,40 @@ const (
 	keyNoColor = "nocolor"
 	keyTask    = "task"
 	keyRule    = "rule"
+
+	keyEnvironment  = "env"
+	keyEnvironments = "environments"
+	keyProfile      = "profile"
+
+	keyRoleArn         = "role-arn"
+	keyExternalID      = "external-id"
+	keyRoleSessionName = "role-session-name"
+	keyMFASerial       = "mfa-serial"
+
+	keyEndpointURL = "endpoint-url"
+	keyEndpoints   = "endpoints"
 )
 
+//the keys an environment stanza of fargate.yml can set
+var environmentKeys = []string{keyCluster, keyService, keyTask, keyRule, keyRegion, keyProfile, keyRoleArn, keyExternalID, keyRoleSessionName, keyMFASerial, keyEndpointURL, keyEndpoints}
+
+//the environment variables keys can come from
+var envVars = map[string]string{
+	keyCluster:         "FARGATE_CLUSTER",
+	keyService:         "FARGATE_SERVICE",
+	keyVerbose:         "FARGATE_VERBOSE",
+	keyNoColor:         "FARGATE_NOCOLOR",
+	keyTask:            "FARGATE_TASK",
+	keyRule:            "FARGATE_RULE",
+	keyEnvironment:     "FARGATE_ENV",
+	keyProfile:         "FARGATE_PROFILE",
+	keyRoleArn:         "FARGATE_ROLE_ARN",
+	keyExternalID:      "FARGATE_EXTERNAL_ID",
+	keyRoleSessionName: "FARGATE_ROLE_SESSION_NAME",
+	keyMFASerial:       "FARGATE_MFA_SERIAL",
+	keyEndpointURL:     "FARGATE_ENDPOINT_URL",
+}
+
 //configure viper to manage parameter input
 func initConfig(cmd *cobra.Command) {
 
@@ -27,18 +61,22 @@ func initConfig(cmd *cobra.Command) {
 	viper.ReadInConfig()
 
 	//env vars
-	viper.BindEnv(keyCluster, "FARGATE_CLUSTER")
-	viper.BindEnv(keyService, "FARGATE_SERVICE")
-	viper.BindEnv(keyVerbose, "FARGATE_VERBOSE")
-	viper.BindEnv(keyNoColor, "FARGATE_NOCOLOR")
-	viper.BindEnv(keyTask, "FARGATE_TASK")
-	viper.BindEnv(keyRule, "FARGATE_RULE")
+	for key, envVar := range envVars {
+		viper.BindEnv(key, envVar)
+	}
 
 	//cli arg
 	initPFlag(keyCluster, cmd)
 	initPFlag(keyVerbose, cmd)
 	initPFlag(keyRegion, cmd)
 	initPFlag(keyNoColor, cmd)
+	initPFlag(keyEnvironment, cmd)
+	initPFlag(keyProfile, cmd)
+	initPFlag(keyRoleArn, cmd)
+	initPFlag(keyExternalID, cmd)
+	initPFlag(keyRoleSessionName, cmd)
+	initPFlag(keyMFASerial, cmd)
+	initPFlag(keyEndpointURL, cmd)
 }
 
 func initPFlag(key string, cmd *cobra.Command) {
@@ -47,7 +85,11 @@ func initPFlag(key string, cmd *cobra.Command) {
 
 //region can come from fargate.yml, AWS_REGION, AWS_DEFAULT_REGION or --region
 func getRegion() string {
-	result := viper.GetString(keyRegion)
+	return getRegionFrom(viper.GetViper())
+}
+
+func getRegionFrom(v *viper.Viper) string {
+	result := v.GetString(keyRegion)
 	if result == "" {
 		envAwsDefaultRegion := os.Getenv("AWS_DEFAULT_REGION")
 		envAwsRegion := os.Getenv("AWS_REGION")
@@ -94,16 +136,84 @@ func getTaskName() string {
 	return result
 }
 
-//rule can come from fargate.yml, FARGATE_RULE, or --task cli arg
+//rule can come from fargate.yml, FARGATE_RULE, or --rule cli arg
 func getRuleName() string {
 	result := viper.GetString(keyRule)
 	if result == "" {
-		fmt.Println("please specify rule using: fargate.yml, FARGATE_RULE envvar, or --task")
+		fmt.Println("please specify rule using: fargate.yml, FARGATE_RULE envvar, or --rule")
 		os.Exit(-1)
 	}
 	return result
 }
 
+//environment can come from FARGATE_ENV or --env cli arg
+func getEnvironment() string {
+	return viper.GetString(keyEnvironment)
+}
+
+//profile can come from fargate.yml, FARGATE_PROFILE, or --profile cli arg
+func getProfile() string {
+	return viper.GetString(keyProfile)
+}
+
+//the role to assume can come from fargate.yml, FARGATE_ROLE_ARN,
+//FARGATE_EXTERNAL_ID, FARGATE_ROLE_SESSION_NAME and FARGATE_MFA_SERIAL, or the
+//matching cli args
+func getAssumeRole() assumeRole {
+	return assumeRole{
+		RoleArn:         viper.GetString(keyRoleArn),
+		ExternalID:      viper.GetString(keyExternalID),
+		RoleSessionName: viper.GetString(keyRoleSessionName),
+		MFASerial:       viper.GetString(keyMFASerial),
+	}
+}
+
+//merges the settings of an environment stanza of fargate.yml over the
+//top-level settings; flags and environment variables still take precedence
+func applyEnvironment(v *viper.Viper, name string) error {
+	if name == "" {
+		return nil
+	}
+
+	environments := v.GetStringMap(keyEnvironments)
+
+	if _, ok := environments[strings.ToLower(name)]; !ok {
+		var names []string
+
+		for environment := range environments {
+			names = append(names, environment)
+		}
+
+		sort.Strings(names)
+
+		return fmt.Errorf("environment %s is not defined in fargate.yml [environments: %s]", name, strings.Join(names, ", "))
+	}
+
+	sub := v.Sub(keyEnvironments + "." + strings.ToLower(name))
+	if sub == nil {
+		return nil
+	}
+
+	settings := sub.AllSettings()
+
+	for key := range settings {
+		if posString(environmentKeys, key) == -1 {
+			return fmt.Errorf("%s cannot be set in environment %s [valid keys: %s]", key, name, strings.Join(environmentKeys, ", "))
+		}
+	}
+
+	return v.MergeConfigMap(settings)
+}
+
+//the endpoint of all services can come from fargate.yml, FARGATE_ENDPOINT_URL,
+//or --endpoint-url cli arg; the endpoints of specific services from fargate.yml
+func getEndpoints() endpointOverrides {
+	return endpointOverrides{
+		URL:      viper.GetString(keyEndpointURL),
+		Services: viper.GetStringMapString(keyEndpoints),
+	}
+}
+
 func getVerbose() bool {
 	return viper.GetBool(keyVerbose)
 }
@@ -0,0 +1,230 @@
+package cmd
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/turnerlabs/fargate/console"
+	yaml "gopkg.in/yaml.v2"
+)
+
+const defaultConfigFile = "fargate.yml"
+
+// the keys config set accepts, besides endpoints.<service>
+var configSetKeys = []string{
+	keyCluster,
+	keyService,
+	keyTask,
+	keyRule,
+	keyRegion,
+	keyProfile,
+	keyRoleArn,
+	keyExternalID,
+	keyRoleSessionName,
+	keyMFASerial,
+	keyEndpointURL,
+	keyVerbose,
+	keyNoColor,
+}
+
+var configSetCmd = &cobra.Command{
+	Use:   "set <key> <value>",
+	Short: "Set a setting in fargate.yml",
+	Long: `Set a setting in fargate.yml
+
+Sets a key of fargate.yml, creating the file if it does not exist. With --env,
+the key is set in that environment, which is created if it does not exist.
+
+The keys are cluster, service, task, rule, region, profile, role-arn,
+external-id, role-session-name, mfa-serial, endpoint-url and endpoints.<service>,
+plus verbose and nocolor outside of environments. Values are validated before
+the file is written. The order of keys is kept, but comments are not.`,
+	Example: `
+fargate config set cluster my-cluster
+fargate config set service my-service --env prod
+fargate config set endpoints.ecs http://localhost:4566 --env local
+`,
+	Args: cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		path := viper.ConfigFileUsed()
+		if path == "" {
+			path = defaultConfigFile
+		}
+
+		if err := setConfigFileValue(path, getEnvironment(), args[0], args[1]); err != nil {
+			console.ErrorExit(err, "Could not set %s", args[0])
+		}
+
+		if environment := getEnvironment(); environment != "" {
+			console.Info("Set %s to %s in environment %s of %s", args[0], args[1], environment, filepath.Base(path))
+		} else {
+			console.Info("Set %s to %s in %s", args[0], args[1], filepath.Base(path))
+		}
+	},
+}
+
+func init() {
+	configCmd.AddCommand(configSetCmd)
+}
+
+// validates a setting, returning the path of the key in fargate.yml and the
+// value to write
+func parseConfigSetting(environment, key, raw string) ([]string, interface{}, error) {
+	path := strings.Split(key, ".")
+
+	if environment != "" {
+		path = append([]string{keyEnvironments, environment}, path...)
+	}
+
+	if len(path) == 2 && path[0] == keyEndpoints || len(path) == 4 && path[2] == keyEndpoints {
+		overrides := endpointOverrides{
+			Services: map[string]string{path[len(path)-1]: raw},
+		}
+
+		return path, raw, overrides.validate()
+	}
+
+	if posString(configSetKeys, key) == -1 {
+		return nil, nil, fmt.Errorf("unknown key %s [keys: %s, %s.<service>]", key, strings.Join(configSetKeys, ", "), keyEndpoints)
+	}
+
+	switch key {
+	case keyVerbose, keyNoColor:
+		if environment != "" {
+			return nil, nil, fmt.Errorf("%s cannot be set in an environment", key)
+		}
+
+		value, err := strconv.ParseBool(raw)
+		return path, value, err
+	case keyRegion:
+		return path, raw, validateRegion(raw)
+	case keyRoleArn:
+		return path, raw, assumeRole{RoleArn: raw}.validate()
+	case keyEndpointURL:
+		return path, raw, validateEndpointURL(raw)
+	}
+
+	return path, raw, nil
+}
+
+// sets the value of the key at a path of a YAML document, keeping the order of
+// the other keys. Keys are matched regardless of case, as viper reads them.
+func setConfigValue(document yaml.MapSlice, path []string, value interface{}) (yaml.MapSlice, error) {
+	for i, item := range document {
+		if !strings.EqualFold(fmt.Sprint(item.Key), path[0]) {
+			continue
+		}
+
+		if len(path) == 1 {
+			document[i].Value = value
+			return document, nil
+		}
+
+		var child yaml.MapSlice
+
+		switch v := item.Value.(type) {
+		case yaml.MapSlice:
+			child = v
+		case nil:
+		default:
+			return nil, fmt.Errorf("%s is not a map", path[0])
+		}
+
+		child, err := setConfigValue(child, path[1:], value)
+		if err != nil {
+			return nil, err
+		}
+
+		document[i].Value = child
+
+		return document, nil
+	}
+
+	if len(path) == 1 {
+		return append(document, yaml.MapItem{Key: path[0], Value: value}), nil
+	}
+
+	child, err := setConfigValue(nil, path[1:], value)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(document, yaml.MapItem{Key: path[0], Value: child}), nil
+}
+
+// sets a key of a YAML config file, replacing the file only once the new
+// contents have been written
+func setConfigFileValue(file, environment, key, raw string) error {
+	return setConfigFileValues(file, environment, []configSetting{{Key: key, Value: raw}})
+}
+
+// sets keys of a YAML config file in order, writing the file once all of them
+// are valid
+func setConfigFileValues(file, environment string, settings []configSetting) error {
+	if ext := filepath.Ext(file); ext != ".yml" && ext != ".yaml" {
+		return fmt.Errorf("%s is not a YAML file", file)
+	}
+
+	var document yaml.MapSlice
+	mode := os.FileMode(0644)
+
+	if info, err := os.Stat(file); err == nil {
+		mode = info.Mode()
+
+		contents, err := ioutil.ReadFile(file)
+		if err != nil {
+			return err
+		}
+
+		if err := yaml.Unmarshal(contents, &document); err != nil {
+			return err
+		}
+	} else if !os.IsNotExist(err) {
+		return err
+	}
+
+	for _, setting := range settings {
+		path, value, err := parseConfigSetting(environment, setting.Key, setting.Value)
+		if err != nil {
+			return err
+		}
+
+		document, err = setConfigValue(document, path, value)
+		if err != nil {
+			return err
+		}
+	}
+
+	contents, err := yaml.Marshal(document)
+	if err != nil {
+		return err
+	}
+
+	temp, err := ioutil.TempFile(filepath.Dir(file), "."+filepath.Base(file))
+	if err != nil {
+		return err
+	}
+
+	defer os.Remove(temp.Name())
+
+	if _, err := temp.Write(contents); err != nil {
+		temp.Close()
+		return err
+	}
+
+	if err := temp.Close(); err != nil {
+		return err
+	}
+
+	if err := os.Chmod(temp.Name(), mode); err != nil {
+		return err
+	}
+
+	return os.Rename(temp.Name(), file)
+}
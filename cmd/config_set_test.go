@@ -0,0 +1,126 @@
+package cmd
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+)
+
+func TestParseConfigSetting(t *testing.T) {
+	path, value, err := parseConfigSetting("prod", "endpoints.ecs", "http://localhost:4566")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if len(path) != 4 || path[0] != keyEnvironments || path[1] != "prod" || value != "http://localhost:4566" {
+		t.Errorf("Expected environments.prod.endpoints.ecs, got %v = %v", path, value)
+	}
+
+	if _, value, _ := parseConfigSetting("", keyVerbose, "true"); value != true {
+		t.Errorf("Expected true, got %v", value)
+	}
+
+	invalid := []struct {
+		environment, key, value string
+	}{
+		{"", "image", "web:1.0"},
+		{"", keyRegion, "ap-northheast-2"},
+		{"", keyRoleArn, "deploy"},
+		{"", "endpoints.s3", "http://localhost:4566"},
+		{"", keyEndpointURL, "localhost:4566"},
+		{"prod", keyNoColor, "true"},
+		{"", keyVerbose, "yes please"},
+	}
+
+	for _, test := range invalid {
+		if _, _, err := parseConfigSetting(test.environment, test.key, test.value); err == nil {
+			t.Errorf("Expected error for %s=%s, got nil", test.key, test.value)
+		}
+	}
+}
+
+func TestSetConfigFileValue(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "fargate.yml")
+	original := "service: web\ncluster: dev\nenvironments:\n  Prod:\n    cluster: prod\n"
+
+	if err := ioutil.WriteFile(file, []byte(original), 0600); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	settings := []struct {
+		environment, key, value string
+	}{
+		{"", keyCluster, "qa"},
+		{"prod", keyService, "web-prod"},
+		{"local", "endpoints.ecs", "http://localhost:4566"},
+	}
+
+	for _, setting := range settings {
+		if err := setConfigFileValue(file, setting.environment, setting.key, setting.value); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+	}
+
+	contents, err := ioutil.ReadFile(file)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	expected := `service: web
+cluster: qa
+environments:
+  Prod:
+    cluster: prod
+    service: web-prod
+  local:
+    endpoints:
+      ecs: http://localhost:4566
+`
+
+	if string(contents) != expected {
+		t.Errorf("Expected %s, got %s", expected, contents)
+	}
+
+	if err := setConfigFileValue(file, "", "image", "web:1.0"); err == nil {
+		t.Error("Expected error for an unknown key, got nil")
+	}
+
+	if err := setConfigFileValue(filepath.Join(t.TempDir(), "fargate.json"), "", keyCluster, "qa"); err == nil {
+		t.Error("Expected error for a JSON file, got nil")
+	}
+}
+
+func TestSetConfigFileValues(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "fargate.yml")
+
+	settings := []configSetting{
+		{Key: keyCluster, Value: "dev"},
+		{Key: keyRegion, Value: "us-west-2"},
+	}
+
+	if err := setConfigFileValues(file, "", settings); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	contents, err := ioutil.ReadFile(file)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if expected := "cluster: dev\nregion: us-west-2\n"; string(contents) != expected {
+		t.Errorf("Expected %s, got %s", expected, contents)
+	}
+
+	invalid := []configSetting{
+		{Key: keyCluster, Value: "qa"},
+		{Key: keyRegion, Value: "us-weast-2"},
+	}
+
+	if err := setConfigFileValues(file, "", invalid); err == nil {
+		t.Error("Expected error for an invalid region, got nil")
+	}
+
+	if contents, _ := ioutil.ReadFile(file); string(contents) != "cluster: dev\nregion: us-west-2\n" {
+		t.Errorf("Expected file to be unchanged, got %s", contents)
+	}
+}
@@ -0,0 +1,243 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+	"github.com/turnerlabs/fargate/console"
+)
+
+const (
+	sourceDefault = "default"
+	sourceNotSet  = "not set"
+)
+
+// the keys shown by config show, in order
+var configShowKeys = []string{
+	keyEnvironment,
+	keyCluster,
+	keyService,
+	keyTask,
+	keyRule,
+	keyRegion,
+	keyProfile,
+	keyRoleArn,
+	keyExternalID,
+	keyRoleSessionName,
+	keyMFASerial,
+	keyEndpointURL,
+	keyVerbose,
+	keyNoColor,
+}
+
+// configSetting is the effective value of a key and where it came from
+type configSetting struct {
+	Key    string
+	Value  string
+	Source string
+}
+
+// configSources are the places settings are read from, in order of precedence
+type configSources struct {
+	Flags           *pflag.FlagSet
+	LookupEnv       func(string) (string, bool)
+	FileName        string
+	File            map[string]interface{}
+	EnvironmentName string
+	Environment     map[string]interface{}
+}
+
+var configCmd = &cobra.Command{
+	Use:   "config",
+	Short: "Show, validate and edit settings",
+	Long: `Show, validate and edit settings
+
+Settings are read from command line flags, FARGATE_* environment variables, the
+selected environment of fargate.yml, the top-level keys of fargate.yml and
+AWS_* environment variables, in that order of precedence.`,
+	PersistentPreRun: func(cmd *cobra.Command, args []string) {
+		//settings are shown and edited without an AWS session
+		if cmd.Annotations[annotationRequiresSession] != "" {
+			rootCmd.PersistentPreRun(cmd, args)
+		}
+	},
+}
+
+var configShowCmd = &cobra.Command{
+	Use:   "show",
+	Short: "Show the effective settings and their sources",
+	Long: `Show the effective settings and their sources
+
+Shows the value of each setting and where it was read from: a flag, an
+environment variable, fargate.yml (or an environment in it), or a default.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		if err := applyEnvironment(viper.GetViper(), getEnvironment()); err != nil {
+			console.ErrorExit(err, "Invalid environment")
+		}
+
+		sources, err := readConfigSources(cmd.Flags())
+		if err != nil {
+			console.ErrorExit(err, "Could not read %s", viper.ConfigFileUsed())
+		}
+
+		printConfigSettings(sources.settings(viper.GetString, viper.GetStringMapString(keyEndpoints)))
+	},
+}
+
+func init() {
+	configCmd.AddCommand(configShowCmd)
+	rootCmd.AddCommand(configCmd)
+}
+
+// reads the top-level and environment settings of fargate.yml, if any, apart
+// from the settings merged by viper
+func readConfigSources(flags *pflag.FlagSet) (configSources, error) {
+	sources := configSources{
+		Flags:           flags,
+		LookupEnv:       os.LookupEnv,
+		EnvironmentName: getEnvironment(),
+	}
+
+	if viper.ConfigFileUsed() == "" {
+		return sources, nil
+	}
+
+	sources.FileName = filepath.Base(viper.ConfigFileUsed())
+
+	v := viper.New()
+	v.SetConfigFile(viper.ConfigFileUsed())
+
+	if err := v.ReadInConfig(); err != nil {
+		return sources, err
+	}
+
+	sources.File = v.AllSettings()
+
+	if sources.EnvironmentName != "" {
+		if environment := v.Sub(keyEnvironments + "." + strings.ToLower(sources.EnvironmentName)); environment != nil {
+			sources.Environment = environment.AllSettings()
+		}
+	}
+
+	return sources, nil
+}
+
+// returns the source of a key, or an empty string if it is not set
+func (c configSources) source(key string) string {
+	if c.Flags != nil {
+		if flag := c.Flags.Lookup(key); flag != nil && flag.Changed {
+			return "flag --" + key
+		}
+	}
+
+	if envVar, ok := envVars[key]; ok {
+		if value, set := c.LookupEnv(envVar); set && value != "" {
+			return "env " + envVar
+		}
+	}
+
+	if _, ok := c.Environment[key]; ok {
+		return fmt.Sprintf("%s (environment %s)", c.FileName, c.EnvironmentName)
+	}
+
+	if _, ok := c.File[key]; ok {
+		return c.FileName
+	}
+
+	return ""
+}
+
+// returns the source of the endpoint of a service, which can only be set in
+// fargate.yml
+func (c configSources) endpointSource(service string) string {
+	if endpoints, ok := c.Environment[keyEndpoints].(map[string]interface{}); ok {
+		if _, ok := endpoints[service]; ok {
+			return fmt.Sprintf("%s (environment %s)", c.FileName, c.EnvironmentName)
+		}
+	}
+
+	return c.FileName
+}
+
+// returns the effective settings given the value of each key and the endpoints
+// of services
+func (c configSources) settings(get func(string) string, endpoints map[string]string) []configSetting {
+	var settings []configSetting
+
+	for _, key := range configShowKeys {
+		setting := configSetting{
+			Key:    key,
+			Value:  get(key),
+			Source: c.source(key),
+		}
+
+		if setting.Source == "" {
+			setting.Value, setting.Source = c.fallback(key)
+		}
+
+		settings = append(settings, setting)
+	}
+
+	var services []string
+
+	for service := range endpoints {
+		services = append(services, service)
+	}
+
+	sort.Strings(services)
+
+	for _, service := range services {
+		settings = append(settings,
+			configSetting{
+				Key:    keyEndpoints + "." + service,
+				Value:  endpoints[service],
+				Source: c.endpointSource(service),
+			},
+		)
+	}
+
+	return settings
+}
+
+// returns the value and source of a key that is not set by fargate
+func (c configSources) fallback(key string) (string, string) {
+	switch key {
+	case keyRegion:
+		for _, envVar := range []string{"AWS_DEFAULT_REGION", "AWS_REGION"} {
+			if value, set := c.LookupEnv(envVar); set && value != "" {
+				return value, "env " + envVar
+			}
+		}
+
+		return defaultRegion, sourceDefault
+	case keyProfile:
+		if value, set := c.LookupEnv("AWS_PROFILE"); set && value != "" {
+			return value, "env AWS_PROFILE"
+		}
+
+		return "default", sourceDefault
+	case keyVerbose, keyNoColor:
+		return "false", sourceDefault
+	}
+
+	return "", sourceNotSet
+}
+
+func printConfigSettings(settings []configSetting) {
+	w := new(tabwriter.Writer)
+	w.Init(os.Stdout, 0, 8, 1, '\t', 0)
+	fmt.Fprintln(w, "KEY\tVALUE\tSOURCE\t")
+
+	for _, setting := range settings {
+		fmt.Fprintf(w, "%s\t%s\t%s\t\n", setting.Key, setting.Value, setting.Source)
+	}
+
+	w.Flush()
+}
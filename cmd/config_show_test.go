@@ -0,0 +1,64 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/spf13/pflag"
+)
+
+func TestConfigSourcesSettings(t *testing.T) {
+	flags := pflag.NewFlagSet("fargate", pflag.ContinueOnError)
+	flags.String(keyCluster, "", "")
+	flags.String(keyEnvironment, "", "")
+	flags.Parse([]string{"--env", "prod"})
+
+	env := map[string]string{
+		"FARGATE_SERVICE": "web-env",
+		"AWS_REGION":      "us-west-2",
+	}
+
+	sources := configSources{
+		Flags: flags,
+		LookupEnv: func(name string) (string, bool) {
+			value, ok := env[name]
+			return value, ok
+		},
+		FileName:        "fargate.yml",
+		File:            map[string]interface{}{keyCluster: "dev", keyTask: "web-task", keyEndpoints: map[string]interface{}{"ecs": "http://localhost:4566"}},
+		EnvironmentName: "prod",
+		Environment:     map[string]interface{}{keyCluster: "prod", keyEndpoints: map[string]interface{}{"logs": "http://localhost:4567"}},
+	}
+
+	values := map[string]string{
+		keyEnvironment: "prod",
+		keyCluster:     "prod",
+		keyService:     "web-env",
+		keyTask:        "web-task",
+	}
+
+	endpoints := map[string]string{"ecs": "http://localhost:4566", "logs": "http://localhost:4567"}
+
+	expected := map[string]configSetting{
+		keyEnvironment:   {keyEnvironment, "prod", "flag --env"},
+		keyCluster:       {keyCluster, "prod", "fargate.yml (environment prod)"},
+		keyService:       {keyService, "web-env", "env FARGATE_SERVICE"},
+		keyTask:          {keyTask, "web-task", "fargate.yml"},
+		keyRule:          {keyRule, "", sourceNotSet},
+		keyRegion:        {keyRegion, "us-west-2", "env AWS_REGION"},
+		keyProfile:       {keyProfile, "default", sourceDefault},
+		"endpoints.ecs":  {"endpoints.ecs", "http://localhost:4566", "fargate.yml"},
+		"endpoints.logs": {"endpoints.logs", "http://localhost:4567", "fargate.yml (environment prod)"},
+	}
+
+	settings := sources.settings(func(key string) string { return values[key] }, endpoints)
+
+	for _, setting := range settings {
+		if want, ok := expected[setting.Key]; ok && setting != want {
+			t.Errorf("Expected %v, got %v", want, setting)
+		}
+	}
+
+	if len(settings) != len(configShowKeys)+len(endpoints) {
+		t.Errorf("Expected %d settings, got %d", len(configShowKeys)+len(endpoints), len(settings))
+	}
+}
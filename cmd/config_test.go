@@ -0,0 +1,82 @@
+package cmd
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/spf13/viper"
+)
+
+const environmentsConfig = `
+cluster: dev
+service: web-dev
+region: us-east-1
+environments:
+  qa:
+    service: web-qa
+  prod:
+    cluster: prod
+    service: web
+    region: us-west-2
+    profile: production
+  empty:
+`
+
+func readTestConfig(t *testing.T, config string) *viper.Viper {
+	v := viper.New()
+	v.SetConfigType("yaml")
+
+	if err := v.ReadConfig(bytes.NewBufferString(config)); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	return v
+}
+
+func TestApplyEnvironment(t *testing.T) {
+	v := readTestConfig(t, environmentsConfig)
+
+	if err := applyEnvironment(v, "prod"); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	for key, expected := range map[string]string{keyCluster: "prod", keyService: "web", keyRegion: "us-west-2", keyProfile: "production"} {
+		if got := v.GetString(key); got != expected {
+			t.Errorf("Expected %s for %s, got %s", expected, key, got)
+		}
+	}
+}
+
+func TestApplyEnvironmentDefaults(t *testing.T) {
+	v := readTestConfig(t, environmentsConfig)
+
+	if err := applyEnvironment(v, "QA"); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if got := v.GetString(keyService); got != "web-qa" {
+		t.Errorf("Expected web-qa, got %s", got)
+	}
+
+	if got := v.GetString(keyCluster); got != "dev" {
+		t.Errorf("Expected the top-level cluster dev, got %s", got)
+	}
+
+	if err := applyEnvironment(v, "empty"); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+}
+
+func TestApplyEnvironmentErrors(t *testing.T) {
+	v := readTestConfig(t, environmentsConfig)
+
+	if err := applyEnvironment(v, "staging"); err == nil {
+		t.Error("Expected error for an undefined environment, got nil")
+	}
+
+	v = readTestConfig(t, "environments:\n  prod:\n    image: web:1.0\n")
+
+	if err := applyEnvironment(v, "prod"); err == nil {
+		t.Error("Expected error for an invalid key, got nil")
+	}
+}
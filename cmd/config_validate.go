@@ -0,0 +1,119 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	CWE "github.com/turnerlabs/fargate/cloudwatchevents"
+	"github.com/turnerlabs/fargate/console"
+	ECS "github.com/turnerlabs/fargate/ecs"
+)
+
+// configCheck is the result of checking that the resource a setting names exists
+type configCheck struct {
+	Key    string
+	Value  string
+	Result string
+	Valid  bool
+}
+
+var configValidateCmd = &cobra.Command{
+	Use:   "validate",
+	Short: "Check that the configured resources exist",
+	Long: `Check that the configured resources exist
+
+Checks that the cluster, the service in the cluster, the task definition family
+and the CloudWatch Events rule that are configured exist. Settings that are not
+set are skipped. Exits with a non-zero status if any check fails.`,
+	Annotations: map[string]string{annotationRequiresSession: "true"},
+	Run: func(cmd *cobra.Command, args []string) {
+		checks := validateConfig(
+			viper.GetString(keyCluster),
+			viper.GetString(keyService),
+			viper.GetString(keyTask),
+			viper.GetString(keyRule),
+		)
+
+		printConfigChecks(checks)
+
+		for _, check := range checks {
+			if !check.Valid {
+				console.IssueExit("Invalid configuration")
+			}
+		}
+	},
+}
+
+func init() {
+	configCmd.AddCommand(configValidateCmd)
+}
+
+func validateConfig(cluster, service, task, rule string) []configCheck {
+	var checks []configCheck
+
+	ecs := ECS.New(sess, cluster)
+	events := CWE.New(sess)
+
+	if cluster != "" {
+		exists, err := ecs.ClusterExists()
+		checks = append(checks, newConfigCheck(keyCluster, cluster, exists, err, "cluster not found"))
+
+		if service != "" {
+			if exists {
+				exists, err := ecs.ServiceExists(service)
+				checks = append(checks, newConfigCheck(keyService, service, exists, err, fmt.Sprintf("service not found in cluster %s", cluster)))
+			} else {
+				checks = append(checks, configCheck{Key: keyService, Value: service, Result: "skipped, cluster not found"})
+			}
+		}
+	} else if service != "" {
+		checks = append(checks, configCheck{Key: keyService, Value: service, Result: "cluster not set"})
+	}
+
+	if task != "" {
+		exists, err := ecs.TaskDefinitionFamilyExists(task)
+		checks = append(checks, newConfigCheck(keyTask, task, exists, err, "task definition family not found"))
+	}
+
+	if rule != "" {
+		exists, err := events.RuleExists(rule)
+		checks = append(checks, newConfigCheck(keyRule, rule, exists, err, "rule not found"))
+	}
+
+	return checks
+}
+
+func newConfigCheck(key, value string, exists bool, err error, notFound string) configCheck {
+	check := configCheck{Key: key, Value: value, Valid: exists && err == nil}
+
+	switch {
+	case err != nil:
+		check.Result = err.Error()
+	case !exists:
+		check.Result = notFound
+	default:
+		check.Result = "ok"
+	}
+
+	return check
+}
+
+func printConfigChecks(checks []configCheck) {
+	if len(checks) == 0 {
+		console.Info("No cluster, service, task or rule configured")
+		return
+	}
+
+	w := new(tabwriter.Writer)
+	w.Init(os.Stdout, 0, 8, 1, '\t', 0)
+	fmt.Fprintln(w, "KEY\tVALUE\tRESULT\t")
+
+	for _, check := range checks {
+		fmt.Fprintf(w, "%s\t%s\t%s\t\n", check.Key, check.Value, check.Result)
+	}
+
+	w.Flush()
+}
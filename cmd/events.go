@@ -15,5 +15,8 @@ The events command provides subcommands for working with CloudWatch Events (sche
 var ruleName string
 
 func init() {
+	eventsCmd.PersistentFlags().StringVar(&ruleName, "rule", "", `CloudWatch Events Rule`)
+	initPFlag(keyRule, eventsCmd)
+
 	rootCmd.AddCommand(eventsCmd)
 }
@@ -0,0 +1,388 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/spf13/cobra"
+	"github.com/turnerlabs/fargate/awserrors"
+	CWE "github.com/turnerlabs/fargate/cloudwatchevents"
+	CWL "github.com/turnerlabs/fargate/cloudwatchlogs"
+	"github.com/turnerlabs/fargate/console"
+	ECS "github.com/turnerlabs/fargate/ecs"
+)
+
+const (
+	defaultEventsHistoryLimit = 20
+
+	//ECS describes at most 100 tasks at a time
+	describeTasksBatchSize = 100
+
+	//the status of runs whose task ECS no longer retains
+	runStatusUnknown = "UNKNOWN"
+)
+
+var (
+	flagEventsHistoryLimit          int
+	flagEventsHistoryIncludeExpired bool
+)
+
+// represents an events history operation
+type eventsHistoryOperation struct {
+	Rule           string
+	Limit          int
+	IncludeExpired bool
+}
+
+// represents a run of a rule target, read from the log stream of its task and,
+// while ECS retains the task, from the task itself
+type eventsHistoryRun struct {
+	Cluster    string
+	TaskId     string
+	FirstLogAt time.Time
+	LastLogAt  time.Time
+	Task       *ECS.Task
+}
+
+func (r *eventsHistoryRun) startedAt() time.Time {
+	if r.Task != nil && !r.Task.StartedAt.IsZero() {
+		return r.Task.StartedAt
+	}
+
+	return r.FirstLogAt
+}
+
+func (r *eventsHistoryRun) stoppedAt() time.Time {
+	if r.Task != nil {
+		return r.Task.StoppedAt
+	}
+
+	return r.LastLogAt
+}
+
+var eventsHistoryCmd = &cobra.Command{
+	Use:   "history",
+	Short: "Show recent runs of a scheduled rule",
+	Long: `Show recent runs of a scheduled rule
+
+Lists the runs of a rule, most recent first, with their start and stop times,
+duration, container exit codes and stop reason.
+
+Runs are the tasks started by the rule (started by events-rule/<rule>). They
+are found in the log streams of the first container of the task definition of
+each target, and among the running tasks of the clusters of the targets. Tasks
+of the same task definition family that were not started by the rule are not
+shown.
+
+ECS only retains stopped tasks for a short time (about an hour), after which
+it can no longer tell which tasks were started by the rule. Use
+--include-expired to also show the older runs found in the log streams; their
+status is UNKNOWN, they may have been started by something else (e.g. a service
+using the same task definition family), and their start and stop times are
+those of their first and last log events.
+
+Use fargate task logs --task <task-id> to view the logs of a run.`,
+	Example: `
+fargate events history --rule nightly-report
+fargate events history --rule nightly-report --limit 5
+fargate events history --rule nightly-report --include-expired
+`,
+	Run: func(cmd *cobra.Command, args []string) {
+		operation := &eventsHistoryOperation{
+			Rule:           getRuleName(),
+			Limit:          flagEventsHistoryLimit,
+			IncludeExpired: flagEventsHistoryIncludeExpired,
+		}
+
+		eventsHistory(operation)
+	},
+}
+
+func init() {
+	eventsHistoryCmd.Flags().IntVar(&flagEventsHistoryLimit, "limit", defaultEventsHistoryLimit, "Maximum number of runs to show (0 for all)")
+	eventsHistoryCmd.Flags().BoolVar(&flagEventsHistoryIncludeExpired, "include-expired", false, "Also show runs whose task ECS no longer retains, which may not have been started by the rule")
+
+	eventsCmd.AddCommand(eventsHistoryCmd)
+}
+
+func eventsHistory(op *eventsHistoryOperation) {
+	events := CWE.New(sess)
+	cwl := CWL.New(sess)
+	startedBy := fmt.Sprintf(eventsRuleStartedByFormat, op.Rule)
+
+	targets, err := events.ListTargets(op.Rule)
+	if err != nil {
+		console.ErrorExit(err, "ListTargetsByRuleInput failed")
+	}
+
+	runs := make(map[string]*eventsHistoryRun)
+
+	var clusters []string
+	for _, target := range targets {
+		if !containsString(clusters, target.ClusterArn) {
+			clusters = append(clusters, target.ClusterArn)
+		}
+
+		ecs := ECS.New(sess, target.ClusterArn)
+
+		dtd, err := ecs.DescribeTaskDefinition(target.TaskDefinitionArn)
+		if err != nil {
+			console.ErrorExit(err, "Could not describe ECS task definition")
+		}
+
+		taskDefinition := dtd.TaskDefinition
+		logConfiguration := ECS.GetContainerLogConfiguration(taskDefinition, "", fmt.Sprintf(taskLogGroupFormat, aws.StringValue(taskDefinition.Family)))
+
+		logStreams, err := cwl.DescribeLogStreams(logConfiguration.LogGroupName, logConfiguration.LogStreamPrefix())
+		if err != nil && !awserrors.IsNotFound(err) {
+			console.ErrorExit(err, "Could not list log streams for: "+logConfiguration.LogGroupName)
+		}
+
+		addLogStreamRuns(runs, target.ClusterArn, logStreams)
+	}
+
+	if len(clusters) == 0 {
+		clusters = append(clusters, getClusterName())
+	}
+
+	for _, cluster := range clusters {
+		ecs := ECS.New(sess, cluster)
+
+		tasks, err := ecs.DescribeRunningTasksStartedBy(startedBy)
+		if err != nil {
+			console.ErrorExit(err, "Could not list ECS tasks")
+		}
+
+		addTaskRuns(runs, cluster, tasks)
+	}
+
+	if len(runs) == 0 {
+		console.Info("No runs found for rule %s", op.Rule)
+		return
+	}
+
+	sortedRuns := selectRuleRuns(sortEventsHistoryRuns(runs), startedBy, op.Limit, op.IncludeExpired, describeClusterTasks)
+
+	if len(sortedRuns) == 0 {
+		console.Info("No runs found for rule %s", op.Rule)
+		return
+	}
+
+	w := new(tabwriter.Writer)
+	w.Init(os.Stdout, 0, 8, 1, '\t', 0)
+	fmt.Fprintln(w, "TASK ID\tREVISION\tSTATUS\tSTARTED\tSTOPPED\tDURATION\tEXIT CODES\tSTOP REASON\t")
+
+	for _, run := range sortedRuns {
+		revision, status, exitCodes, stoppedReason := "-", runStatusUnknown, "-", "-"
+
+		if run.Task != nil {
+			revision = run.Task.DeploymentId
+			status = run.Task.LastStatus
+			exitCodes = formatExitCodes(run.Task.Containers)
+			stoppedReason = run.Task.StoppedReason
+		}
+
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t\n",
+			run.TaskId,
+			revision,
+			status,
+			formatHistoryTime(run.startedAt()),
+			formatHistoryTime(run.stoppedAt()),
+			runDuration(run.startedAt(), run.stoppedAt()),
+			exitCodes,
+			stoppedReason,
+		)
+	}
+
+	w.Flush()
+
+	console.Info("View the logs of a run with: fargate task logs --task <task-id>")
+}
+
+// adds the runs of the tasks that logged to log streams named
+// <prefix>/<container>/<task-id>
+func addLogStreamRuns(runs map[string]*eventsHistoryRun, cluster string, logStreams []CWL.LogStream) {
+	for _, logStream := range logStreams {
+		taskId := logStream.Name[strings.LastIndex(logStream.Name, "/")+1:]
+
+		run, ok := runs[taskId]
+		if !ok {
+			run = &eventsHistoryRun{Cluster: cluster, TaskId: taskId}
+			runs[taskId] = run
+		}
+
+		if run.FirstLogAt.IsZero() || logStream.FirstEventTime.Before(run.FirstLogAt) {
+			run.FirstLogAt = logStream.FirstEventTime
+		}
+
+		if logStream.LastEventTime.After(run.LastLogAt) {
+			run.LastLogAt = logStream.LastEventTime
+		}
+	}
+}
+
+// adds the runs of tasks described by ECS
+func addTaskRuns(runs map[string]*eventsHistoryRun, cluster string, tasks []ECS.Task) {
+	for i := range tasks {
+		run, ok := runs[tasks[i].TaskId]
+		if !ok {
+			run = &eventsHistoryRun{Cluster: cluster, TaskId: tasks[i].TaskId}
+			runs[tasks[i].TaskId] = run
+		}
+
+		run.Task = &tasks[i]
+	}
+}
+
+// returns runs, most recent first
+func sortEventsHistoryRuns(runs map[string]*eventsHistoryRun) []*eventsHistoryRun {
+	var sorted []*eventsHistoryRun
+
+	for _, run := range runs {
+		sorted = append(sorted, run)
+	}
+
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].startedAt().After(sorted[j].startedAt())
+	})
+
+	return sorted
+}
+
+// describes tasks of a cluster; tasks that ECS no longer retains are omitted
+func describeClusterTasks(cluster string, taskIds []string) ([]ECS.Task, error) {
+	ecs := ECS.New(sess, cluster)
+
+	tasks, err := ecs.DescribeTasks(taskIds)
+	if awserrors.IsNotFound(err) {
+		return tasks, nil
+	}
+
+	return tasks, err
+}
+
+// returns the runs, most recent first, whose task was started by the rule.
+// Runs found only in log streams are described in batches until the limit is
+// reached; runs whose task ECS no longer retains are only returned when
+// includeExpired is set, as it is not known who started them.
+func selectRuleRuns(runs []*eventsHistoryRun, startedBy string, limit int, includeExpired bool, describe func(cluster string, taskIds []string) ([]ECS.Task, error)) []*eventsHistoryRun {
+	var selected []*eventsHistoryRun
+
+	//once a whole batch has expired, older runs have expired too
+	expired := false
+
+	for len(runs) > 0 && (limit <= 0 || len(selected) < limit) {
+		batch := runs
+		if len(batch) > describeTasksBatchSize {
+			batch = batch[:describeTasksBatchSize]
+		}
+
+		runs = runs[len(batch):]
+
+		if !expired {
+			expired = describeRuns(batch, describe)
+		}
+
+		for _, run := range batch {
+			if run.Task != nil && run.Task.StartedBy != startedBy {
+				continue
+			}
+
+			if run.Task == nil && !includeExpired {
+				continue
+			}
+
+			selected = append(selected, run)
+
+			if limit > 0 && len(selected) == limit {
+				break
+			}
+		}
+
+		if expired && !includeExpired {
+			break
+		}
+	}
+
+	return selected
+}
+
+// describes the tasks of runs found only in log streams and returns whether
+// none of them are retained by ECS any more
+func describeRuns(runs []*eventsHistoryRun, describe func(cluster string, taskIds []string) ([]ECS.Task, error)) bool {
+	taskIds := make(map[string][]string)
+
+	for _, run := range runs {
+		if run.Task == nil {
+			taskIds[run.Cluster] = append(taskIds[run.Cluster], run.TaskId)
+		}
+	}
+
+	if len(taskIds) == 0 {
+		return false
+	}
+
+	found := 0
+
+	for cluster, clusterTaskIds := range taskIds {
+		tasks, err := describe(cluster, clusterTaskIds)
+		if err != nil {
+			console.ErrorExit(err, "Could not describe ECS tasks")
+		}
+
+		for i := range tasks {
+			for _, run := range runs {
+				if run.TaskId == tasks[i].TaskId {
+					run.Task = &tasks[i]
+					found++
+				}
+			}
+		}
+	}
+
+	return found == 0
+}
+
+func formatHistoryTime(t time.Time) string {
+	if t.IsZero() {
+		return "-"
+	}
+
+	return t.Local().Format(timeFormat)
+}
+
+// returns how long a run took, or has been running
+func runDuration(startedAt, stoppedAt time.Time) string {
+	if startedAt.IsZero() {
+		return "-"
+	}
+
+	if stoppedAt.IsZero() {
+		stoppedAt = time.Now()
+	}
+
+	return stoppedAt.Sub(startedAt).Truncate(time.Second).String()
+}
+
+func formatExitCodes(containers []ECS.Container) string {
+	var exitCodes []string
+
+	for _, container := range containers {
+		if container.ExitCode == nil {
+			continue
+		}
+
+		exitCodes = append(exitCodes, fmt.Sprintf("%s=%d", container.Name, aws.Int64Value(container.ExitCode)))
+	}
+
+	if len(exitCodes) == 0 {
+		return "-"
+	}
+
+	return strings.Join(exitCodes, ",")
+}
@@ -0,0 +1,144 @@
+package cmd
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	CWL "github.com/turnerlabs/fargate/cloudwatchlogs"
+	ECS "github.com/turnerlabs/fargate/ecs"
+)
+
+func TestFormatExitCodes(t *testing.T) {
+	containers := []ECS.Container{
+		ECS.Container{Name: "app", ExitCode: aws.Int64(1)},
+		ECS.Container{Name: "sidecar"},
+		ECS.Container{Name: "nginx", ExitCode: aws.Int64(0)},
+	}
+
+	if got := formatExitCodes(containers); got != "app=1,nginx=0" {
+		t.Errorf("Expected app=1,nginx=0, got %s", got)
+	}
+
+	if got := formatExitCodes([]ECS.Container{}); got != "-" {
+		t.Errorf("Expected -, got %s", got)
+	}
+}
+
+func TestRunDuration(t *testing.T) {
+	startedAt := time.Date(2020, 1, 1, 6, 0, 0, 0, time.UTC)
+
+	if got := runDuration(startedAt, startedAt.Add(90*time.Second)); got != "1m30s" {
+		t.Errorf("Expected 1m30s, got %s", got)
+	}
+
+	if got := runDuration(time.Time{}, time.Time{}); got != "-" {
+		t.Errorf("Expected -, got %s", got)
+	}
+}
+
+func TestAddLogStreamRuns(t *testing.T) {
+	startedAt := time.Date(2020, 1, 1, 6, 0, 0, 0, time.UTC)
+	runs := make(map[string]*eventsHistoryRun)
+
+	addLogStreamRuns(runs, "my-cluster", []CWL.LogStream{
+		CWL.LogStream{Name: "fargate/app/abc", FirstEventTime: startedAt.Add(time.Second), LastEventTime: startedAt.Add(time.Minute)},
+		CWL.LogStream{Name: "fargate/sidecar/abc", FirstEventTime: startedAt, LastEventTime: startedAt.Add(2 * time.Minute)},
+		CWL.LogStream{Name: "fargate/app/def", FirstEventTime: startedAt.Add(time.Hour), LastEventTime: startedAt.Add(time.Hour)},
+	})
+
+	if len(runs) != 2 {
+		t.Fatalf("Expected 2 runs, got %d", len(runs))
+	}
+
+	run := runs["abc"]
+
+	if run.Cluster != "my-cluster" {
+		t.Errorf("Expected cluster my-cluster, got %s", run.Cluster)
+	}
+
+	if !run.FirstLogAt.Equal(startedAt) {
+		t.Errorf("Expected first log at %s, got %s", startedAt, run.FirstLogAt)
+	}
+
+	if !run.LastLogAt.Equal(startedAt.Add(2 * time.Minute)) {
+		t.Errorf("Expected last log at %s, got %s", startedAt.Add(2*time.Minute), run.LastLogAt)
+	}
+}
+
+func TestSortEventsHistoryRuns(t *testing.T) {
+	startedAt := time.Date(2020, 1, 1, 6, 0, 0, 0, time.UTC)
+	runs := make(map[string]*eventsHistoryRun)
+
+	addLogStreamRuns(runs, "my-cluster", []CWL.LogStream{
+		CWL.LogStream{Name: "fargate/app/old", FirstEventTime: startedAt, LastEventTime: startedAt},
+	})
+	addTaskRuns(runs, "my-cluster", []ECS.Task{
+		ECS.Task{TaskId: "running", LastStatus: "RUNNING", StartedAt: startedAt.Add(time.Hour)},
+	})
+
+	sorted := sortEventsHistoryRuns(runs)
+
+	if len(sorted) != 2 || sorted[0].TaskId != "running" || sorted[1].TaskId != "old" {
+		t.Fatalf("Expected runs running, old, got %v", sorted)
+	}
+
+	if sorted[0].Task == nil {
+		t.Errorf("Expected the running run to have its task")
+	}
+
+	if !sorted[1].stoppedAt().Equal(startedAt) {
+		t.Errorf("Expected the old run to stop at its last log event, got %s", sorted[1].stoppedAt())
+	}
+}
+
+func TestSelectRuleRuns(t *testing.T) {
+	startedAt := time.Date(2020, 1, 1, 6, 0, 0, 0, time.UTC)
+	startedBy := "events-rule/nightly"
+	runs := make(map[string]*eventsHistoryRun)
+
+	addLogStreamRuns(runs, "my-cluster", []CWL.LogStream{
+		CWL.LogStream{Name: "fargate/app/rule", FirstEventTime: startedAt.Add(3 * time.Hour)},
+		CWL.LogStream{Name: "fargate/app/service", FirstEventTime: startedAt.Add(2 * time.Hour)},
+		CWL.LogStream{Name: "fargate/app/expired", FirstEventTime: startedAt},
+	})
+	addTaskRuns(runs, "my-cluster", []ECS.Task{
+		ECS.Task{TaskId: "running", LastStatus: "RUNNING", StartedBy: startedBy, StartedAt: startedAt.Add(4 * time.Hour)},
+	})
+
+	describe := func(cluster string, taskIds []string) ([]ECS.Task, error) {
+		var tasks []ECS.Task
+
+		for _, taskId := range taskIds {
+			switch taskId {
+			case "rule":
+				tasks = append(tasks, ECS.Task{TaskId: taskId, LastStatus: "STOPPED", StartedBy: startedBy})
+			case "service":
+				tasks = append(tasks, ECS.Task{TaskId: taskId, LastStatus: "STOPPED", StartedBy: "ecs-svc/1234"})
+			}
+		}
+
+		return tasks, nil
+	}
+
+	got := func(selected []*eventsHistoryRun) []string {
+		var taskIds []string
+		for _, run := range selected {
+			taskIds = append(taskIds, run.TaskId)
+		}
+		return taskIds
+	}
+
+	if selected := got(selectRuleRuns(sortEventsHistoryRuns(runs), startedBy, 0, false, describe)); strings.Join(selected, ",") != "running,rule" {
+		t.Errorf("Expected running,rule, got %v", selected)
+	}
+
+	if selected := got(selectRuleRuns(sortEventsHistoryRuns(runs), startedBy, 1, false, describe)); strings.Join(selected, ",") != "running" {
+		t.Errorf("Expected running, got %v", selected)
+	}
+
+	if selected := got(selectRuleRuns(sortEventsHistoryRuns(runs), startedBy, 0, true, describe)); strings.Join(selected, ",") != "running,rule,expired" {
+		t.Errorf("Expected running,rule,expired, got %v", selected)
+	}
+}
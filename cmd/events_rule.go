@@ -0,0 +1,25 @@
+package cmd
+
+import (
+	"regexp"
+
+	"github.com/spf13/cobra"
+)
+
+const defaultEventsRoleName = "ecsEventsRole"
+
+var validScheduleExpression = regexp.MustCompile(`^(cron|rate)\(.+\)$`)
+
+var eventsRuleCmd = &cobra.Command{
+	Use:   "rule",
+	Short: "Manage scheduled task rules",
+	Long: `Manage scheduled task rules
+
+Scheduled tasks are CloudWatch Events rules with a schedule expression that
+target an ECS task definition. Rules can be created, listed, described, enabled,
+disabled and deleted.`,
+}
+
+func init() {
+	eventsCmd.AddCommand(eventsRuleCmd)
+}
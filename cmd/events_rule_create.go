@@ -0,0 +1,276 @@
+package cmd
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	awsecs "github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	CWE "github.com/turnerlabs/fargate/cloudwatchevents"
+	"github.com/turnerlabs/fargate/console"
+	ECS "github.com/turnerlabs/fargate/ecs"
+	STS "github.com/turnerlabs/fargate/sts"
+)
+
+var (
+	flagEventsRuleCreateSchedule         string
+	flagEventsRuleCreateDescription      string
+	flagEventsRuleCreateRole             string
+	flagEventsRuleCreateCount            int64
+	flagEventsRuleCreateRevision         string
+	flagEventsRuleCreateTargetId         string
+	flagEventsRuleCreateService          string
+	flagEventsRuleCreateSubnetIds        []string
+	flagEventsRuleCreateSecurityGroupIds []string
+	flagEventsRuleCreateAssignPublicIp   bool
+	flagEventsRuleCreatePlatformVersion  string
+	flagEventsRuleCreateContainerName    string
+	flagEventsRuleCreateCommand          []string
+	flagEventsRuleCreateEnvVars          []string
+	flagEventsRuleCreateEnvFile          string
+)
+
+// represents an events rule create operation
+type eventsRuleCreateOperation struct {
+	Cluster           string
+	Task              string
+	Rule              string
+	Schedule          string
+	Description       string
+	Role              string
+	Count             int64
+	Revision          string
+	TargetId          string
+	Service           string
+	SubnetIds         []string
+	SecurityGroupIds  []string
+	AssignPublicIp    bool
+	AssignPublicIpSet bool
+	PlatformVersion   string
+	ContainerName     string
+	Command           []string
+	EnvVars           []ECS.EnvVar
+}
+
+func (o *eventsRuleCreateOperation) validate() {
+	if !validScheduleExpression.MatchString(o.Schedule) {
+		console.IssueExit(`--schedule must be a cron or rate expression [e.g. "cron(0 12 * * ? *)" or "rate(5 minutes)"]`)
+	}
+
+	if o.Count < 1 {
+		console.IssueExit("--count must be at least 1")
+	}
+
+	if o.Service == "" && len(o.SubnetIds) == 0 {
+		console.IssueExit("Network configuration is required (specify --service or --subnet-id)")
+	}
+}
+
+var eventsRuleCreateCmd = &cobra.Command{
+	Use:   "create --schedule <expression>",
+	Short: "Create a scheduled task rule",
+	Long: `Create a scheduled task rule
+
+Creates (or updates) a CloudWatch Events rule with a cron or rate schedule
+expression and a target that runs the latest revision of the task family (or
+the revision given by --revision).
+
+The network configuration of the task is either copied from an existing service
+(--service) or specified with --subnet-id, --security-group-id and
+--assign-public-ip. When both are used, the flags take precedence.
+
+The command and environment variables of a container (the first container of the
+task definition unless --container-name is given) can be overridden with
+--command, --env and --env-file. --command is given once per argument of the
+command, so arguments can contain spaces (use --command=<arg> for arguments
+that start with a dash).
+
+The rule target assumes an IAM role that is allowed to run the task (--role). The
+role can be specified either by name or by ARN and defaults to ecsEventsRole.`,
+	Example: `
+fargate events rule create --rule nightly-report --schedule "cron(0 6 * * ? *)" --service my-app
+fargate events rule create --rule cleanup --schedule "rate(5 minutes)" --subnet-id subnet-1234 --security-group-id sg-1234
+fargate events rule create --rule nightly-report --schedule "cron(0 6 * * ? *)" --service my-app --command bin/report --command=--all --env MODE=nightly
+`,
+	PreRun: func(cmd *cobra.Command, args []string) {
+		viper.BindPFlag(keyTask, cmd.Flags().Lookup(keyTask))
+	},
+	Run: func(cmd *cobra.Command, args []string) {
+		operation := &eventsRuleCreateOperation{
+			Cluster:           getClusterName(),
+			Task:              getTaskName(),
+			Rule:              getRuleName(),
+			Schedule:          flagEventsRuleCreateSchedule,
+			Description:       flagEventsRuleCreateDescription,
+			Role:              flagEventsRuleCreateRole,
+			Count:             flagEventsRuleCreateCount,
+			Revision:          flagEventsRuleCreateRevision,
+			TargetId:          flagEventsRuleCreateTargetId,
+			Service:           flagEventsRuleCreateService,
+			SubnetIds:         flagEventsRuleCreateSubnetIds,
+			SecurityGroupIds:  flagEventsRuleCreateSecurityGroupIds,
+			AssignPublicIp:    flagEventsRuleCreateAssignPublicIp,
+			AssignPublicIpSet: cmd.Flags().Changed("assign-public-ip"),
+			PlatformVersion:   flagEventsRuleCreatePlatformVersion,
+			ContainerName:     flagEventsRuleCreateContainerName,
+			Command:           flagEventsRuleCreateCommand,
+			EnvVars:           processEnvVarArgs(flagEventsRuleCreateEnvVars, flagEventsRuleCreateEnvFile),
+		}
+
+		if operation.TargetId == "" {
+			operation.TargetId = operation.Task
+		}
+
+		operation.validate()
+		eventsRuleCreate(operation)
+	},
+}
+
+func init() {
+	eventsRuleCreateCmd.Flags().StringP(keyTask, "t", "", "ECS task family name")
+	eventsRuleCreateCmd.Flags().StringVar(&flagEventsRuleCreateSchedule, "schedule", "", `Schedule expression [e.g. "cron(0 12 * * ? *)" or "rate(5 minutes)"]`)
+	eventsRuleCreateCmd.Flags().StringVar(&flagEventsRuleCreateDescription, "description", "", "Description of the rule")
+	eventsRuleCreateCmd.Flags().StringVar(&flagEventsRuleCreateRole, "role", defaultEventsRoleName, "Name or ARN of the IAM role used by CloudWatch Events to run the task")
+	eventsRuleCreateCmd.Flags().Int64Var(&flagEventsRuleCreateCount, "count", 1, "Number of tasks to run")
+	eventsRuleCreateCmd.Flags().StringVarP(&flagEventsRuleCreateRevision, "revision", "r", "", "Task definition revision (defaults to the latest revision)")
+	eventsRuleCreateCmd.Flags().StringVar(&flagEventsRuleCreateTargetId, "target-id", "", "ID of the rule target (defaults to the task family name)")
+	eventsRuleCreateCmd.Flags().StringVar(&flagEventsRuleCreateService, "service", "", "Service to copy the network configuration from")
+	eventsRuleCreateCmd.Flags().StringSliceVar(&flagEventsRuleCreateSubnetIds, "subnet-id", []string{}, "ID of a subnet in which to run the task")
+	eventsRuleCreateCmd.Flags().StringSliceVar(&flagEventsRuleCreateSecurityGroupIds, "security-group-id", []string{}, "ID of a security group to apply to the task")
+	eventsRuleCreateCmd.Flags().BoolVar(&flagEventsRuleCreateAssignPublicIp, "assign-public-ip", true, "Assign a public IP address to the task")
+	eventsRuleCreateCmd.Flags().StringVar(&flagEventsRuleCreatePlatformVersion, "platform-version", "", "Fargate platform version (defaults to LATEST)")
+	eventsRuleCreateCmd.Flags().StringVarP(&flagEventsRuleCreateContainerName, "container-name", "n", "", "Container to apply the command and environment overrides to")
+	eventsRuleCreateCmd.Flags().StringArrayVar(&flagEventsRuleCreateCommand, "command", []string{}, "Command override for the container, one argument per flag [e.g. --command bin/report --command=--all]")
+	eventsRuleCreateCmd.Flags().StringArrayVarP(&flagEventsRuleCreateEnvVars, "env", "e", []string{}, "Environment variable overrides [e.g. KEY=value]")
+	eventsRuleCreateCmd.Flags().StringVarP(&flagEventsRuleCreateEnvFile, "env-file", "f", "", "File containing environment variable overrides, one per line, of the form KEY=value")
+
+	eventsRuleCmd.AddCommand(eventsRuleCreateCmd)
+}
+
+func eventsRuleCreate(op *eventsRuleCreateOperation) {
+	events := CWE.New(sess)
+	ecs := ECS.New(sess, op.Cluster)
+
+	taskDefinition, err := ecs.DescribeTaskDefinition(op.Task)
+	if err != nil {
+		console.ErrorExit(err, "Could not describe ECS task definition")
+	}
+
+	taskDefinitionArn := aws.StringValue(taskDefinition.TaskDefinition.TaskDefinitionArn)
+	if op.Revision != "" {
+		taskDefinitionArn = resolveTaskDefinitionRevision(&ecs, op.Task, op.Revision)
+	}
+
+	clusterArn, err := ecs.GetClusterArn()
+	if err != nil {
+		console.ErrorExit(err, "Could not describe ECS cluster")
+	}
+
+	target := CWE.Target{
+		Id:                op.TargetId,
+		ClusterArn:        clusterArn,
+		RoleArn:           resolveRoleArn(op.Role),
+		TaskDefinitionArn: taskDefinitionArn,
+		TaskCount:         op.Count,
+		LaunchType:        awsecs.CompatibilityFargate,
+		PlatformVersion:   op.PlatformVersion,
+		SubnetIds:         op.SubnetIds,
+		SecurityGroupIds:  op.SecurityGroupIds,
+		AssignPublicIp:    awsecs.AssignPublicIpDisabled,
+	}
+
+	if op.Service != "" {
+		service, err := ecs.DescribeService(op.Service)
+		if err != nil {
+			console.ErrorExit(err, "Could not describe ECS service")
+		}
+
+		if len(target.SubnetIds) == 0 {
+			target.SubnetIds = service.SubnetIds
+		}
+
+		if len(target.SecurityGroupIds) == 0 {
+			target.SecurityGroupIds = service.SecurityGroupIds
+		}
+
+		if !op.AssignPublicIpSet {
+			op.AssignPublicIp = service.AssignPublicIp == awsecs.AssignPublicIpEnabled
+		}
+	}
+
+	if op.AssignPublicIp {
+		target.AssignPublicIp = awsecs.AssignPublicIpEnabled
+	}
+
+	containerName := op.ContainerName
+	if containerName == "" {
+		taskDefinition, err := ecs.DescribeTaskDefinition(taskDefinitionArn)
+		if err != nil {
+			console.ErrorExit(err, "Could not describe ECS task definition")
+		}
+
+		containerName = aws.StringValue(taskDefinition.TaskDefinition.ContainerDefinitions[0].Name)
+	}
+
+	if override := ECS.NewTaskOverride(containerName, op.Command, op.EnvVars); override != nil {
+		input, err := ECS.MarshalTaskOverride(override)
+		if err != nil {
+			console.ErrorExit(err, "Could not build container overrides")
+		}
+
+		target.Input = input
+	}
+
+	exists, err := events.RuleExists(op.Rule)
+	if err != nil {
+		console.ErrorExit(err, "Could not describe rule %s", op.Rule)
+	}
+
+	ruleArn, err := events.PutRule(
+		&CWE.PutRuleInput{
+			Name:               op.Rule,
+			Description:        op.Description,
+			ScheduleExpression: op.Schedule,
+		},
+	)
+	if err != nil {
+		console.ErrorExit(err, "Could not create rule %s", op.Rule)
+	}
+
+	if err := events.PutTargets(op.Rule, []CWE.Target{target}); err != nil {
+		console.ErrorExit(err, "PutTargets failed")
+	}
+
+	if exists {
+		console.Info("Updated rule %s", ruleArn)
+	} else {
+		console.Info("Created rule %s", ruleArn)
+	}
+	console.Info("- Schedule: %s", op.Schedule)
+	console.Info("- Target %s: %s (count %d)", target.Id, taskDefinitionArn, target.TaskCount)
+}
+
+// returns the arn of an IAM role given its name or arn
+func resolveRoleArn(role string) string {
+	if arn.IsARN(role) {
+		return role
+	}
+
+	sts := STS.New(sess)
+	callerIdentity, err := sts.GetCallerIdentity()
+	if err != nil {
+		console.ErrorExit(err, "Error calling GetCallerIdentity")
+	}
+
+	identity, err := arn.Parse(callerIdentity.ARN)
+	if err != nil {
+		console.ErrorExit(err, "Could not parse caller identity")
+	}
+
+	return arn.ARN{
+		Partition: identity.Partition,
+		Service:   "iam",
+		AccountID: identity.AccountID,
+		Resource:  "role/" + role,
+	}.String()
+}
@@ -0,0 +1,48 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	CWE "github.com/turnerlabs/fargate/cloudwatchevents"
+	"github.com/turnerlabs/fargate/console"
+)
+
+var flagEventsRuleDeleteYes bool
+
+var eventsRuleDeleteCmd = &cobra.Command{
+	Use:   "delete",
+	Short: "Delete a scheduled task rule",
+	Long: `Delete a scheduled task rule
+
+Removes all targets of the rule and deletes it. You will be asked for
+confirmation unless --yes is specified.`,
+	Example: `
+fargate events rule delete --rule nightly-report
+fargate events rule delete --rule nightly-report --yes
+`,
+	Run: func(cmd *cobra.Command, args []string) {
+		rule := getRuleName()
+
+		if !flagEventsRuleDeleteYes {
+			fmt.Printf("Delete rule %s and all of its targets? (yes/no) ", rule)
+
+			if !askForConfirmation() {
+				console.InfoExit("Rule not deleted")
+			}
+		}
+
+		events := CWE.New(sess)
+		if err := events.DeleteRule(rule); err != nil {
+			console.ErrorExit(err, "Could not delete rule %s", rule)
+		}
+
+		console.Info("Deleted rule %s", rule)
+	},
+}
+
+func init() {
+	eventsRuleDeleteCmd.Flags().BoolVarP(&flagEventsRuleDeleteYes, "yes", "y", false, "Delete without asking for confirmation")
+
+	eventsRuleCmd.AddCommand(eventsRuleDeleteCmd)
+}
@@ -0,0 +1,104 @@
+package cmd
+
+import (
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/spf13/cobra"
+	CWE "github.com/turnerlabs/fargate/cloudwatchevents"
+	"github.com/turnerlabs/fargate/console"
+	ECS "github.com/turnerlabs/fargate/ecs"
+)
+
+var eventsRuleDescribeCmd = &cobra.Command{
+	Use:   "describe",
+	Short: "Describe a scheduled task rule",
+	Long: `Describe a scheduled task rule
+
+Shows the schedule and state of a rule along with the task definition, task
+count, network configuration and container overrides of each of its targets.`,
+	Example: `
+fargate events rule describe --rule nightly-report
+`,
+	Run: func(cmd *cobra.Command, args []string) {
+		eventsRuleDescribe(getRuleName())
+	},
+}
+
+func init() {
+	eventsRuleCmd.AddCommand(eventsRuleDescribeCmd)
+}
+
+func eventsRuleDescribe(name string) {
+	events := CWE.New(sess)
+	rule, err := events.DescribeRule(name)
+	if err != nil {
+		console.ErrorExit(err, "Could not describe rule %s", name)
+	}
+
+	targets, err := events.ListTargets(name)
+	if err != nil {
+		console.ErrorExit(err, "ListTargetsByRuleInput failed")
+	}
+
+	console.KeyValue("Rule Name", "%s\n", rule.Name)
+	console.KeyValue("Arn", "%s\n", rule.Arn)
+	console.KeyValue("Schedule", "%s\n", rule.ScheduleExpression)
+	console.KeyValue("State", "%s\n", rule.State)
+
+	if rule.Description != "" {
+		console.KeyValue("Description", "%s\n", rule.Description)
+	}
+
+	if len(targets) == 0 {
+		console.KeyValue("Targets", "None\n")
+		return
+	}
+
+	console.Header("Targets")
+
+	for _, target := range targets {
+		console.KeyValue(target.Id, "\n")
+		console.KeyValue("  Cluster", "%s\n", target.ClusterArn)
+		console.KeyValue("  Task Definition", "%s\n", target.TaskDefinitionArn)
+		console.KeyValue("  Count", "%d\n", target.TaskCount)
+		if target.LaunchType != "" {
+			console.KeyValue("  Launch Type", "%s\n", target.LaunchType)
+		}
+
+		for _, item := range target.CapacityProviderStrategy {
+			console.KeyValue("  Capacity Provider", "%s (base %d, weight %d)\n", item.CapacityProvider, item.Base, item.Weight)
+		}
+
+		if target.PlatformVersion != "" {
+			console.KeyValue("  Platform Version", "%s\n", target.PlatformVersion)
+		}
+
+		console.KeyValue("  Role", "%s\n", target.RoleArn)
+		console.KeyValue("  Subnets", "%s\n", strings.Join(target.SubnetIds, ", "))
+		console.KeyValue("  Security Groups", "%s\n", strings.Join(target.SecurityGroupIds, ", "))
+		console.KeyValue("  Public IP", "%s\n", target.AssignPublicIp)
+
+		if target.Input == "" {
+			continue
+		}
+
+		override, err := ECS.UnmarshalTaskOverride(target.Input)
+		if err != nil {
+			console.KeyValue("  Input", "%s\n", target.Input)
+			continue
+		}
+
+		for _, container := range override.ContainerOverrides {
+			console.KeyValue("  Overrides", "%s\n", aws.StringValue(container.Name))
+
+			if len(container.Command) > 0 {
+				console.KeyValue("    Command", "%s\n", strings.Join(aws.StringValueSlice(container.Command), " "))
+			}
+
+			for _, env := range container.Environment {
+				console.KeyValue("    "+aws.StringValue(env.Name), "%s\n", aws.StringValue(env.Value))
+			}
+		}
+	}
+}
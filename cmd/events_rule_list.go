@@ -0,0 +1,71 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	CWE "github.com/turnerlabs/fargate/cloudwatchevents"
+	"github.com/turnerlabs/fargate/console"
+)
+
+var flagEventsRuleListPrefix string
+
+var eventsRuleListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List scheduled task rules",
+	Long: `List scheduled task rules
+
+Lists the rules that have a schedule expression, optionally filtered by a name
+prefix.`,
+	Example: `
+fargate events rule list
+fargate events rule list --prefix nightly-
+`,
+	Run: func(cmd *cobra.Command, args []string) {
+		eventsRuleList(flagEventsRuleListPrefix)
+	},
+}
+
+func init() {
+	eventsRuleListCmd.Flags().StringVar(&flagEventsRuleListPrefix, "prefix", "", "Only list rules whose name starts with this prefix")
+
+	eventsRuleCmd.AddCommand(eventsRuleListCmd)
+}
+
+func eventsRuleList(prefix string) {
+	events := CWE.New(sess)
+
+	allRules, err := events.ListRules(prefix)
+	if err != nil {
+		console.ErrorExit(err, "Could not list rules")
+	}
+
+	var rules []CWE.Rule
+	for _, rule := range allRules {
+		if rule.ScheduleExpression != "" {
+			rules = append(rules, rule)
+		}
+	}
+
+	if len(rules) == 0 {
+		console.Info("No scheduled rules found")
+		return
+	}
+
+	w := new(tabwriter.Writer)
+	w.Init(os.Stdout, 0, 8, 1, '\t', 0)
+	fmt.Fprintln(w, "NAME\tSCHEDULE\tSTATE\tDESCRIPTION\t")
+
+	for _, rule := range rules {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t\n",
+			rule.Name,
+			rule.ScheduleExpression,
+			rule.State,
+			rule.Description,
+		)
+	}
+
+	w.Flush()
+}
@@ -0,0 +1,52 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+	CWE "github.com/turnerlabs/fargate/cloudwatchevents"
+	"github.com/turnerlabs/fargate/console"
+)
+
+var eventsRuleEnableCmd = &cobra.Command{
+	Use:   "enable",
+	Short: "Enable a scheduled task rule",
+	Example: `
+fargate events rule enable --rule nightly-report
+`,
+	Run: func(cmd *cobra.Command, args []string) {
+		rule := getRuleName()
+		events := CWE.New(sess)
+
+		if err := events.EnableRule(rule); err != nil {
+			console.ErrorExit(err, "Could not enable rule %s", rule)
+		}
+
+		console.Info("Enabled rule %s", rule)
+	},
+}
+
+var eventsRuleDisableCmd = &cobra.Command{
+	Use:   "disable",
+	Short: "Disable a scheduled task rule",
+	Long: `Disable a scheduled task rule
+
+A disabled rule keeps its schedule and targets but does not run any tasks until
+it is enabled again.`,
+	Example: `
+fargate events rule disable --rule nightly-report
+`,
+	Run: func(cmd *cobra.Command, args []string) {
+		rule := getRuleName()
+		events := CWE.New(sess)
+
+		if err := events.DisableRule(rule); err != nil {
+			console.ErrorExit(err, "Could not disable rule %s", rule)
+		}
+
+		console.Info("Disabled rule %s", rule)
+	},
+}
+
+func init() {
+	eventsRuleCmd.AddCommand(eventsRuleEnableCmd)
+	eventsRuleCmd.AddCommand(eventsRuleDisableCmd)
+}
@@ -0,0 +1,20 @@
+package cmd
+
+import "testing"
+
+func TestValidScheduleExpression(t *testing.T) {
+	valid := []string{"cron(0 12 * * ? *)", "rate(5 minutes)", "rate(1 day)"}
+	invalid := []string{"", "0 12 * * ? *", "rate()", "every(5 minutes)", "cron(0 12 * * ? *) "}
+
+	for _, expression := range valid {
+		if !validScheduleExpression.MatchString(expression) {
+			t.Errorf("Expected %s to be valid", expression)
+		}
+	}
+
+	for _, expression := range invalid {
+		if validScheduleExpression.MatchString(expression) {
+			t.Errorf("Expected %s to be invalid", expression)
+		}
+	}
+}
@@ -0,0 +1,349 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awsecs "github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/spf13/cobra"
+	CWE "github.com/turnerlabs/fargate/cloudwatchevents"
+	CWL "github.com/turnerlabs/fargate/cloudwatchlogs"
+	"github.com/turnerlabs/fargate/console"
+	ECS "github.com/turnerlabs/fargate/ecs"
+)
+
+const (
+	eventsRuleStartedByFormat = "events-rule/%s"
+	taskStatusStopped         = "STOPPED"
+	taskPollInterval          = 6 * time.Second
+)
+
+var (
+	flagEventsRunTargetIds []string
+	flagEventsRunWait      bool
+	flagEventsRunFollow    bool
+	flagEventsRunTime      bool
+)
+
+// represents an events run operation
+type eventsRunOperation struct {
+	Rule        string
+	TargetIds   []string
+	Wait        bool
+	Follow      bool
+	IncludeTime bool
+}
+
+// represents tasks started for a rule target
+type eventsRunTasks struct {
+	ecs               ECS.ECS
+	TargetId          string
+	TaskDefinitionArn string
+	TaskIds           []string
+}
+
+var eventsRunCmd = &cobra.Command{
+	Use:   "run",
+	Short: "Run the tasks of a scheduled rule immediately",
+	Long: `Run the tasks of a scheduled rule immediately
+
+Runs the task of each target of a rule exactly as the rule would: with the same
+task definition, task count, network configuration and container overrides.
+Use --target-id to only run specific targets.
+
+--wait waits until the tasks have stopped and reports their exit codes. The
+command fails if any container exits with a non-zero exit code. --follow also
+prints the logs of the tasks until they have stopped.`,
+	Example: `
+fargate events run --rule nightly-report
+fargate events run --rule nightly-report --wait
+fargate events run --rule nightly-report --follow --time
+`,
+	Run: func(cmd *cobra.Command, args []string) {
+		operation := &eventsRunOperation{
+			Rule:        getRuleName(),
+			TargetIds:   flagEventsRunTargetIds,
+			Wait:        flagEventsRunWait || flagEventsRunFollow,
+			Follow:      flagEventsRunFollow,
+			IncludeTime: flagEventsRunTime,
+		}
+
+		eventsRun(operation)
+	},
+}
+
+func init() {
+	eventsRunCmd.Flags().StringSliceVar(&flagEventsRunTargetIds, "target-id", []string{}, "ID of a rule target to run (can be specified multiple times)")
+	eventsRunCmd.Flags().BoolVarP(&flagEventsRunWait, "wait", "w", false, "Wait for the tasks to stop and report their exit codes")
+	eventsRunCmd.Flags().BoolVarP(&flagEventsRunFollow, "follow", "f", false, "Print the logs of the tasks until they stop (implies --wait)")
+	eventsRunCmd.Flags().BoolVarP(&flagEventsRunTime, "time", "T", false, "append time to logs")
+
+	eventsCmd.AddCommand(eventsRunCmd)
+}
+
+func eventsRun(op *eventsRunOperation) {
+	events := CWE.New(sess)
+
+	ruleTargets, err := events.ListTargets(op.Rule)
+	if err != nil {
+		console.ErrorExit(err, "ListTargetsByRuleInput failed")
+	}
+
+	targets, err := selectEventsTargets(ruleTargets, op.TargetIds, len(op.TargetIds) == 0)
+	if err != nil {
+		console.ErrorExit(err, "Could not select targets of rule %s", op.Rule)
+	}
+
+	var runs []eventsRunTasks
+
+	for _, target := range targets {
+		var overrides *awsecs.TaskOverride
+
+		if target.Input != "" {
+			overrides, err = ECS.UnmarshalTaskOverride(target.Input)
+			if err != nil {
+				console.ErrorExit(err, "Could not parse the input of target %s", target.Id)
+			}
+		}
+
+		ecs := ECS.New(sess, target.ClusterArn)
+		taskIds, err := ecs.RunTask(newEventsRunTaskInput(op.Rule, target, overrides))
+
+		if len(taskIds) == 0 {
+			console.ErrorExit(err, "Could not run target %s", target.Id)
+		}
+
+		if err != nil {
+			console.Issue("Could not run ECS task: %s", err)
+		}
+
+		console.Info("Running target %s (%s)", target.Id, target.TaskDefinitionArn)
+
+		for _, taskId := range taskIds {
+			console.Info("- %s", taskId)
+		}
+
+		runs = append(runs, eventsRunTasks{ecs: ecs, TargetId: target.Id, TaskDefinitionArn: target.TaskDefinitionArn, TaskIds: taskIds})
+	}
+
+	if !op.Wait {
+		return
+	}
+
+	var wg sync.WaitGroup
+	results := make([][]ECS.Task, len(runs))
+	errs := make([]error, len(runs))
+
+	//errors are reported once all targets are done so that one target does
+	//not stop waiting for the others
+	for i := range runs {
+		wg.Add(1)
+
+		go func(i int) {
+			defer wg.Done()
+
+			if op.Follow {
+				if errs[i] = followEventsRunLogs(&runs[i], op.IncludeTime); errs[i] != nil {
+					return
+				}
+			}
+
+			results[i], errs[i] = waitForTasksToStop(&runs[i].ecs, runs[i].TaskIds)
+		}(i)
+	}
+
+	wg.Wait()
+
+	failed := false
+
+	for i, tasks := range results {
+		if errs[i] != nil {
+			console.Issue("Could not wait for the tasks of target %s: %s", runs[i].TargetId, errs[i])
+			failed = true
+
+			continue
+		}
+
+		for _, task := range tasks {
+			if !reportStoppedTask(runs[i].TargetId, task) {
+				failed = true
+			}
+		}
+	}
+
+	if failed {
+		console.IssueExit("One or more tasks of rule %s failed", op.Rule)
+	}
+}
+
+// builds the input to run the tasks of a rule target as the rule would
+func newEventsRunTaskInput(rule string, target CWE.Target, overrides *awsecs.TaskOverride) *ECS.RunTaskInput {
+	//rules run one task if the target does not set a count
+	count := target.TaskCount
+	if count == 0 {
+		count = 1
+	}
+
+	//rules do not assign a public IP address unless the target does
+	assignPublicIp := target.AssignPublicIp
+	if assignPublicIp == "" {
+		assignPublicIp = awsecs.AssignPublicIpDisabled
+	}
+
+	input := &ECS.RunTaskInput{
+		AssignPublicIp:    assignPublicIp,
+		ClusterName:       target.ClusterArn,
+		Count:             count,
+		Group:             target.Group,
+		LaunchType:        target.LaunchType,
+		Overrides:         overrides,
+		PlatformVersion:   target.PlatformVersion,
+		SecurityGroupIds:  target.SecurityGroupIds,
+		StartedBy:         fmt.Sprintf(eventsRuleStartedByFormat, rule),
+		SubnetIds:         target.SubnetIds,
+		TaskDefinitionArn: target.TaskDefinitionArn,
+	}
+
+	for _, item := range target.CapacityProviderStrategy {
+		input.CapacityProviderStrategy = append(input.CapacityProviderStrategy,
+			ECS.CapacityProviderStrategyItem{
+				CapacityProvider: item.CapacityProvider,
+				Base:             item.Base,
+				Weight:           item.Weight,
+			},
+		)
+	}
+
+	return input
+}
+
+// follows the logs of tasks until they have stopped, or until they could not
+// be described
+func followEventsRunLogs(run *eventsRunTasks, includeTime bool) error {
+	dtd, err := run.ecs.DescribeTaskDefinition(run.TaskDefinitionArn)
+	if err != nil {
+		return err
+	}
+
+	taskDefinition := dtd.TaskDefinition
+	logConfiguration := ECS.GetContainerLogConfiguration(taskDefinition, "", fmt.Sprintf(taskLogGroupFormat, aws.StringValue(taskDefinition.Family)))
+
+	var lastCheck time.Time
+	var describeErr error
+
+	operation := &GetLogsOperation{
+		Follow:      true,
+		IncludeTime: includeTime,
+		cwl:         CWL.New(sess),
+		StopFollowing: func() bool {
+			if time.Since(lastCheck) < taskPollInterval {
+				return false
+			}
+
+			lastCheck = time.Now()
+
+			tasks, err := run.ecs.DescribeTasks(run.TaskIds)
+			if err != nil {
+				describeErr = err
+				return true
+			}
+
+			stopped, err := tasksStopped(tasks, run.TaskIds)
+			if err != nil {
+				describeErr = err
+				return true
+			}
+
+			return stopped
+		},
+	}
+
+	operation.AddLogSource("", logConfiguration)
+	operation.AddTasks(run.TaskIds)
+
+	GetLogs(operation)
+
+	return describeErr
+}
+
+// polls tasks until all of them have stopped
+func waitForTasksToStop(ecs *ECS.ECS, taskIds []string) ([]ECS.Task, error) {
+	for {
+		tasks, err := ecs.DescribeTasks(taskIds)
+		if err != nil {
+			return nil, err
+		}
+
+		stopped, err := tasksStopped(tasks, taskIds)
+		if err != nil {
+			return nil, err
+		}
+
+		if stopped {
+			return tasks, nil
+		}
+
+		time.Sleep(taskPollInterval)
+	}
+}
+
+// returns whether all of the tasks have stopped; all of the tasks must have been
+// described
+func tasksStopped(tasks []ECS.Task, taskIds []string) (bool, error) {
+	if len(tasks) != len(taskIds) {
+		return false, fmt.Errorf("described %d of %d tasks", len(tasks), len(taskIds))
+	}
+
+	for _, task := range tasks {
+		if task.LastStatus != taskStatusStopped {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+// prints the outcome of a stopped task and returns whether all of its
+// containers exited successfully
+func reportStoppedTask(targetId string, task ECS.Task) bool {
+	success := true
+
+	var exitCodes []string
+
+	for _, container := range task.Containers {
+		if container.ExitCode == nil {
+			success = false
+			exitCodes = append(exitCodes, fmt.Sprintf("%s=none", container.Name))
+			continue
+		}
+
+		if aws.Int64Value(container.ExitCode) != 0 {
+			success = false
+		}
+
+		exitCodes = append(exitCodes, fmt.Sprintf("%s=%d", container.Name, aws.Int64Value(container.ExitCode)))
+	}
+
+	startedAt := task.StartedAt
+	if startedAt.IsZero() {
+		startedAt = task.CreatedAt
+	}
+
+	message := fmt.Sprintf("%s %s stopped after %s (exit codes: %s)",
+		targetId,
+		task.TaskId,
+		task.StoppedAt.Sub(startedAt).Truncate(time.Second),
+		strings.Join(exitCodes, ", "),
+	)
+
+	if success {
+		console.Info("%s", message)
+	} else {
+		console.Issue("%s: %s", message, task.StoppedReason)
+	}
+
+	return success
+}
@@ -0,0 +1,77 @@
+package cmd
+
+import (
+	"testing"
+
+	awsecs "github.com/aws/aws-sdk-go/service/ecs"
+	CWE "github.com/turnerlabs/fargate/cloudwatchevents"
+	ECS "github.com/turnerlabs/fargate/ecs"
+)
+
+func TestNewEventsRunTaskInput(t *testing.T) {
+	input := newEventsRunTaskInput("nightly", CWE.Target{TaskDefinitionArn: "nightly:1", LaunchType: awsecs.LaunchTypeFargate}, nil)
+
+	if input.Count != 1 {
+		t.Errorf("Expected count 1 for a target without a count, got %d", input.Count)
+	}
+
+	if input.LaunchType != awsecs.LaunchTypeFargate {
+		t.Errorf("Expected %s, got %s", awsecs.LaunchTypeFargate, input.LaunchType)
+	}
+
+	if input.AssignPublicIp != awsecs.AssignPublicIpDisabled {
+		t.Errorf("Expected %s for a target without a public IP setting, got %s", awsecs.AssignPublicIpDisabled, input.AssignPublicIp)
+	}
+
+	if input.StartedBy != "events-rule/nightly" {
+		t.Errorf("Expected events-rule/nightly, got %s", input.StartedBy)
+	}
+}
+
+func TestNewEventsRunTaskInput_CapacityProviderStrategy(t *testing.T) {
+	target := CWE.Target{
+		TaskDefinitionArn: "nightly:1",
+		TaskCount:         3,
+		CapacityProviderStrategy: []CWE.CapacityProviderStrategyItem{
+			CWE.CapacityProviderStrategyItem{CapacityProvider: "FARGATE", Base: 1, Weight: 1},
+			CWE.CapacityProviderStrategyItem{CapacityProvider: "FARGATE_SPOT", Weight: 3},
+		},
+	}
+
+	input := newEventsRunTaskInput("nightly", target, nil)
+
+	if input.Count != 3 {
+		t.Errorf("Expected count 3, got %d", input.Count)
+	}
+
+	if input.LaunchType != "" {
+		t.Errorf("Expected no launch type, got %s", input.LaunchType)
+	}
+
+	if len(input.CapacityProviderStrategy) != 2 {
+		t.Fatalf("Expected 2 capacity providers, got %d", len(input.CapacityProviderStrategy))
+	}
+
+	item := input.CapacityProviderStrategy[1]
+
+	if item.CapacityProvider != "FARGATE_SPOT" || item.Weight != 3 {
+		t.Errorf("Unexpected capacity provider %v", item)
+	}
+}
+
+func TestTasksStopped(t *testing.T) {
+	stopped := ECS.Task{TaskId: "abc", LastStatus: taskStatusStopped}
+	running := ECS.Task{TaskId: "def", LastStatus: "RUNNING"}
+
+	if ok, err := tasksStopped([]ECS.Task{stopped}, []string{"abc"}); !ok || err != nil {
+		t.Errorf("Expected stopped, got %t (%v)", ok, err)
+	}
+
+	if ok, err := tasksStopped([]ECS.Task{stopped, running}, []string{"abc", "def"}); ok || err != nil {
+		t.Errorf("Expected not stopped, got %t (%v)", ok, err)
+	}
+
+	if _, err := tasksStopped([]ECS.Task{}, []string{"abc"}); err == nil {
+		t.Error("Expected error for a missing task, got nil")
+	}
+}
@@ -1,47 +1,68 @@
 package cmd
 
 import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/turnerlabs/fargate/console"
 	"github.com/turnerlabs/fargate/ecs"
-	"github.com/turnerlabs/fargate/sts"
 
 	"github.com/spf13/cobra"
 	"github.com/turnerlabs/fargate/cloudwatchevents"
 )
 
-var flagEventsTargetRule string
+const revisionLatest = "latest"
+
 var flagEventsTargetRevision string
+var flagEventsTargetIds []string
+var flagEventsTargetAll bool
 
 //represents an events target operation
 type eventsTargetOperation struct {
-	Cluster  string
-	Task     string
-	Rule     string
-	Revision string
-	Region   string
+	Cluster   string
+	Rule      string
+	Revision  string
+	TargetIds []string
+	All       bool
 }
 
 func (o *eventsTargetOperation) validate() {
 	if o.Revision == "" {
 		console.IssueExit("--revision is required")
 	}
+
+	if o.All && len(o.TargetIds) > 0 {
+		console.IssueExit("--all and --target-id cannot be used together")
+	}
 }
 
 var eventsTargetCmd = &cobra.Command{
 	Use:   "target",
-	Short: "Updates an event rule target to run a particular task definition revision.",
+	Short: "Updates event rule targets to run a particular task definition revision.",
+	Long: `Updates event rule targets to run a particular task definition revision.
+
+The revision can either be absolute, a delta specified with a sign such as +1 or
+-1 relative to the revision each target currently runs, or "latest" for the
+latest revision of each target's task family. Revisions are resolved within the
+task family of each target, so a rule can target several task families.
+
+If the rule has more than one target, specify the targets to update with
+--target-id (repeatable) or update all of them with --all.`,
 	Example: `
 fargate events target --revision <revision>
 fargate events target --rule <rule> --revision <revision>
+fargate events target --rule <rule> --revision latest --all
+fargate events target --rule <rule> --revision -1 --target-id report --target-id cleanup
 	`,
 	Run: func(cmd *cobra.Command, args []string) {
 
 		operation := eventsTargetOperation{
-			Cluster:  getClusterName(),
-			Task:     getTaskName(),
-			Rule:     getRuleName(),
-			Revision: flagEventsTargetRevision,
-			Region:   region,
+			Cluster:   getClusterName(),
+			Rule:      getRuleName(),
+			Revision:  flagEventsTargetRevision,
+			TargetIds: flagEventsTargetIds,
+			All:       flagEventsTargetAll,
 		}
 
 		operation.validate()
@@ -50,11 +71,9 @@ fargate events target --rule <rule> --revision <revision>
 }
 
 func init() {
-	eventsTargetCmd.PersistentFlags().StringVarP(&flagEventsTargetRule, "rule", "", "", `CloudWatch Events Rule`)
-
-	eventsTargetCmd.PersistentFlags().StringVarP(&flagEventsTargetRevision, "revision", "r", "", `Task Definition Revision Number`)
-
-	initPFlag(keyRule, eventsTargetCmd)
+	eventsTargetCmd.PersistentFlags().StringVarP(&flagEventsTargetRevision, "revision", "r", "", `Task Definition Revision Number, delta (+N/-N) or "latest"`)
+	eventsTargetCmd.Flags().StringSliceVar(&flagEventsTargetIds, "target-id", []string{}, "ID of a rule target to update (can be specified multiple times)")
+	eventsTargetCmd.Flags().BoolVar(&flagEventsTargetAll, "all", false, "Update all targets of the rule")
 
 	eventsCmd.AddCommand(eventsTargetCmd)
 }
@@ -62,16 +81,97 @@ func init() {
 func eventsTarget(op eventsTargetOperation) {
 	events := cloudwatchevents.New(sess)
 	ecs := ecs.New(sess, op.Cluster)
-	sts := sts.New(sess)
 
-	//look up account
-	account := sts.GetCallerIdentity().Account
+	ruleTargets, err := events.ListTargets(op.Rule)
+	if err != nil {
+		console.ErrorExit(err, "ListTargetsByRuleInput failed")
+	}
+
+	targets, err := selectEventsTargets(ruleTargets, op.TargetIds, op.All)
+	if err != nil {
+		console.ErrorExit(err, "Could not select targets of rule %s", op.Rule)
+	}
+
+	taskDefinitionArns := make(map[string]string)
+	currentArns := make(map[string]string)
+
+	for _, target := range targets {
+		//resolve the arn currently targeted (which may omit the revision)
+		current, err := ecs.DescribeTaskDefinition(target.TaskDefinitionArn)
+		if err != nil {
+			console.ErrorExit(err, "Could not describe ECS task definition")
+		}
+
+		currentArn := aws.StringValue(current.TaskDefinition.TaskDefinitionArn)
+		family := ecs.GetTaskFamily(currentArn)
 
-	//build full task definiton arn with revision
-	tdARN := ecs.GetTaskDefinitionARN(op.Region, account, op.Task, op.Revision)
+		revisionArn := family
+		if op.Revision != revisionLatest {
+			revisionNumber := ecs.ResolveRevisionNumber(currentArn, op.Revision)
+			if revisionNumber == "" {
+				console.IssueExit("Could not resolve revision %s for target %s", op.Revision, target.Id)
+			}
 
-	//update target
-	events.UpdateTargetRevision(op.Rule, tdARN)
+			revisionArn = family + ":" + revisionNumber
+		}
+
+		revision, err := ecs.DescribeTaskDefinition(revisionArn)
+		if err != nil {
+			console.ErrorExit(err, "Could not describe ECS task definition")
+		}
+
+		currentArns[target.Id] = currentArn
+		taskDefinitionArns[target.Id] = aws.StringValue(revision.TaskDefinition.TaskDefinitionArn)
+	}
+
+	//update targets
+	if err := events.UpdateTargetTaskDefinitions(op.Rule, taskDefinitionArns); err != nil {
+		console.ErrorExit(err, "Could not update targets of rule %s", op.Rule)
+	}
+
+	console.Info("rule %v now targeting:", op.Rule)
+
+	for _, target := range targets {
+		console.Info("- %s: %s:%s -> %s:%s",
+			target.Id,
+			ecs.GetTaskFamily(currentArns[target.Id]),
+			ecs.GetRevisionNumber(currentArns[target.Id]),
+			ecs.GetTaskFamily(taskDefinitionArns[target.Id]),
+			ecs.GetRevisionNumber(taskDefinitionArns[target.Id]),
+		)
+	}
+}
+
+// selects the rule targets to update by id, or all of them; a rule with a
+// single target does not need a selection
+func selectEventsTargets(targets []cloudwatchevents.Target, ids []string, all bool) ([]cloudwatchevents.Target, error) {
+	if len(targets) == 0 {
+		return nil, fmt.Errorf("no ECS task targets found")
+	}
+
+	if all || (len(ids) == 0 && len(targets) == 1) {
+		return targets, nil
+	}
+
+	var available []string
+	for _, target := range targets {
+		available = append(available, target.Id)
+	}
+
+	if len(ids) == 0 {
+		return nil, fmt.Errorf("rule has %d targets (%s), specify --target-id or --all", len(targets), strings.Join(available, ", "))
+	}
+
+	var selected []cloudwatchevents.Target
+
+	for _, id := range ids {
+		pos := posString(available, id)
+		if pos == -1 {
+			return nil, fmt.Errorf("target %s not found (available: %s)", id, strings.Join(available, ", "))
+		}
+
+		selected = append(selected, targets[pos])
+	}
 
-	console.Info("rule %v now targeting revision %v", op.Rule, op.Revision)
+	return selected, nil
 }
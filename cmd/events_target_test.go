@@ -0,0 +1,50 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/turnerlabs/fargate/cloudwatchevents"
+)
+
+func TestSelectEventsTargets(t *testing.T) {
+	targets := []cloudwatchevents.Target{
+		cloudwatchevents.Target{Id: "report"},
+		cloudwatchevents.Target{Id: "cleanup"},
+	}
+
+	if _, err := selectEventsTargets(targets, []string{}, false); err == nil {
+		t.Error("Expected error when a rule has multiple targets and none are selected")
+	}
+
+	if _, err := selectEventsTargets(targets, []string{"missing"}, false); err == nil {
+		t.Error("Expected error for an unknown target id")
+	}
+
+	if _, err := selectEventsTargets([]cloudwatchevents.Target{}, []string{}, true); err == nil {
+		t.Error("Expected error when a rule has no targets")
+	}
+
+	selected, err := selectEventsTargets(targets, []string{"cleanup"}, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(selected) != 1 || selected[0].Id != "cleanup" {
+		t.Errorf("Expected [cleanup], got %v", selected)
+	}
+
+	selected, err = selectEventsTargets(targets, []string{}, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(selected) != 2 {
+		t.Errorf("Expected 2 targets, got %d", len(selected))
+	}
+
+	selected, err = selectEventsTargets(targets[:1], []string{}, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(selected) != 1 || selected[0].Id != "report" {
+		t.Errorf("Expected [report], got %v", selected)
+	}
+}
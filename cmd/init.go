@@ -0,0 +1,359 @@
+package cmd
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	CWE "github.com/turnerlabs/fargate/cloudwatchevents"
+	"github.com/turnerlabs/fargate/console"
+	ECS "github.com/turnerlabs/fargate/ecs"
+	"golang.org/x/crypto/ssh/terminal"
+)
+
+const defaultComposeFile = "docker-compose.yml"
+
+var (
+	flagInitService  string
+	flagInitTask     string
+	flagInitRule     string
+	flagInitCompose  bool
+	flagInitNoPrompt bool
+	flagInitForce    bool
+)
+
+// InitOperation is the cluster, service, task definition family and rule to
+// write to fargate.yml
+type InitOperation struct {
+	Cluster string
+	Service string
+	Task    string
+	Rule    string
+	Region  string
+	Compose bool
+	Force   bool
+	Prompt  *prompter
+}
+
+// prompter asks for settings on a terminal
+type prompter struct {
+	in  *bufio.Reader
+	out io.Writer
+}
+
+var initCmd = &cobra.Command{
+	Use:   "init",
+	Short: "Create fargate.yml from the resources in your account",
+	Long: `Create fargate.yml from the resources in your account
+
+Lists the clusters in the region, then the services in the chosen cluster, the
+task definition families and the CloudWatch Events rules, and offers them to
+choose from. Values given with flags are not asked for. Without a terminal, or
+with --no-prompt, only the flags are used.
+
+The chosen resources are checked before fargate.yml is written. With --compose,
+a docker-compose.yml file labeled to be deployed is also written from the
+latest revision of the task definition family, as "fargate task describe" would
+render it.
+
+An existing fargate.yml or docker-compose.yml is only changed with --force, in
+which case the other settings of fargate.yml are kept.`,
+	Example: `
+# choose from the resources in your account
+fargate init
+
+# without prompts
+fargate init --cluster my-cluster --service my-service --task my-app --no-prompt
+
+# also write a docker-compose.yml file
+fargate init --compose
+`,
+	Args:        cobra.NoArgs,
+	Annotations: map[string]string{annotationRequiresSession: "true"},
+	Run: func(cmd *cobra.Command, args []string) {
+		if getEnvironment() != "" {
+			console.IssueExit("fargate init writes the top-level settings of fargate.yml (use fargate config set --env to edit environments)")
+		}
+
+		operation := &InitOperation{
+			Cluster: clusterName,
+			Service: flagInitService,
+			Task:    flagInitTask,
+			Rule:    flagInitRule,
+			Compose: flagInitCompose,
+			Force:   flagInitForce,
+		}
+
+		if cmd.Flags().Changed(keyRegion) {
+			operation.Region = region
+		}
+
+		if !flagInitNoPrompt && terminal.IsTerminal(int(os.Stdin.Fd())) {
+			operation.Prompt = &prompter{
+				in:  bufio.NewReader(os.Stdin),
+				out: os.Stdout,
+			}
+		}
+
+		initConfigFile(operation)
+	},
+}
+
+func init() {
+	initCmd.Flags().StringVarP(&flagInitService, "service", "s", "", "ECS service name")
+	initCmd.Flags().StringVarP(&flagInitTask, "task", "t", "", "ECS task definition family name")
+	initCmd.Flags().StringVar(&flagInitRule, "rule", "", "CloudWatch Events rule name")
+	initCmd.Flags().BoolVar(&flagInitCompose, "compose", false, "Also write docker-compose.yml from the task definition")
+	initCmd.Flags().BoolVar(&flagInitNoPrompt, "no-prompt", false, "Do not prompt for values that are not given with flags")
+	initCmd.Flags().BoolVar(&flagInitForce, "force", false, "Change existing fargate.yml and docker-compose.yml files")
+
+	rootCmd.AddCommand(initCmd)
+}
+
+func initConfigFile(operation *InitOperation) {
+	path := viper.ConfigFileUsed()
+	if path == "" {
+		path = defaultConfigFile
+	}
+
+	if _, err := os.Stat(path); err == nil && !operation.Force {
+		console.IssueExit("%s already exists (use --force to change it)", path)
+	}
+
+	if operation.Prompt != nil {
+		if err := operation.choose(); err != nil {
+			console.ErrorExit(err, "Could not choose settings")
+		}
+	}
+
+	if operation.Cluster == "" && operation.Service == "" && operation.Task == "" && operation.Rule == "" {
+		console.IssueExit("No cluster, service, task or rule chosen")
+	}
+
+	if operation.Compose {
+		if operation.Task == "" {
+			console.IssueExit("--compose requires a task definition family")
+		}
+
+		if _, err := os.Stat(defaultComposeFile); err == nil && !operation.Force {
+			console.IssueExit("%s already exists (use --force to replace it)", defaultComposeFile)
+		}
+	}
+
+	checks := validateConfig(operation.Cluster, operation.Service, operation.Task, operation.Rule)
+	printConfigChecks(checks)
+
+	for _, check := range checks {
+		if !check.Valid {
+			console.IssueExit("Invalid configuration, %s not written", path)
+		}
+	}
+
+	if err := setConfigFileValues(path, "", operation.settings()); err != nil {
+		console.ErrorExit(err, "Could not write %s", path)
+	}
+
+	console.Info("Wrote %s", path)
+
+	if !operation.Compose {
+		return
+	}
+
+	ecs := ECS.New(sess, operation.Cluster)
+
+	dtd, err := ecs.DescribeTaskDefinition(operation.Task)
+	if err != nil {
+		console.ErrorExit(err, "Could not describe ECS task definition")
+	}
+
+	contents, err := composeFromTaskDefinition(dtd.TaskDefinition)
+	if err != nil {
+		console.ErrorExit(err, "Could not render %s", defaultComposeFile)
+	}
+
+	if err := ioutil.WriteFile(defaultComposeFile, contents, 0644); err != nil {
+		console.ErrorExit(err, "Could not write %s", defaultComposeFile)
+	}
+
+	console.Info("Wrote %s", defaultComposeFile)
+}
+
+// asks for the settings that were not given with flags
+func (o *InitOperation) choose() error {
+	var err error
+
+	if o.Cluster == "" {
+		ecs := ECS.New(sess, "")
+
+		clusters, err := ecs.ListClusters()
+		if err != nil {
+			return fmt.Errorf("could not list ECS clusters: %w", err)
+		}
+
+		if o.Cluster, err = o.Prompt.choose("Clusters", "Cluster", clusters, ""); err != nil {
+			return err
+		}
+	}
+
+	//the family of the chosen service is offered as the task definition family
+	var serviceFamily string
+
+	if o.Cluster != "" && o.Service == "" {
+		ecs := ECS.New(sess, o.Cluster)
+		services, err := ecs.ListServices()
+		if err != nil {
+			return fmt.Errorf("could not list ECS services: %w", err)
+		}
+
+		families := make(map[string]string)
+
+		var names []string
+
+		for _, service := range services {
+			names = append(names, service.Name)
+			families[service.Name] = ecs.GetTaskFamily(service.TaskDefinitionArn)
+		}
+
+		if o.Service, err = o.Prompt.choose("Services in "+o.Cluster, "Service", names, ""); err != nil {
+			return err
+		}
+
+		serviceFamily = families[o.Service]
+	}
+
+	if o.Task == "" {
+		ecs := ECS.New(sess, o.Cluster)
+
+		families, err := ecs.ListTaskDefinitionFamilies()
+		if err != nil {
+			return fmt.Errorf("could not list task definition families: %w", err)
+		}
+
+		if o.Task, err = o.Prompt.choose("Task definition families", "Task definition family", families, serviceFamily); err != nil {
+			return err
+		}
+	}
+
+	if o.Rule == "" {
+		events := CWE.New(sess)
+
+		rules, err := events.ListRules("")
+		if err != nil {
+			return fmt.Errorf("could not list rules: %w", err)
+		}
+
+		var names []string
+
+		for _, rule := range rules {
+			names = append(names, rule.Name)
+		}
+
+		if o.Rule, err = o.Prompt.choose("CloudWatch Events rules", "Rule", names, ""); err != nil {
+			return err
+		}
+	}
+
+	if o.Task != "" && !o.Compose {
+		if o.Compose, err = o.Prompt.confirm(fmt.Sprintf("Write %s from task definition family %s?", defaultComposeFile, o.Task)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// returns the settings to write, in order
+func (o *InitOperation) settings() []configSetting {
+	var settings []configSetting
+
+	for _, setting := range []configSetting{
+		{Key: keyCluster, Value: o.Cluster},
+		{Key: keyService, Value: o.Service},
+		{Key: keyTask, Value: o.Task},
+		{Key: keyRule, Value: o.Rule},
+		{Key: keyRegion, Value: o.Region},
+	} {
+		if setting.Value != "" {
+			settings = append(settings, setting)
+		}
+	}
+
+	return settings
+}
+
+// offers numbered options, returning the chosen option or the name that was
+// typed. An empty answer returns the default, and - skips the setting.
+func (p *prompter) choose(heading, label string, options []string, defaultOption string) (string, error) {
+	if len(options) == 0 {
+		fmt.Fprintf(p.out, "No %s found\n", strings.ToLower(heading))
+	} else {
+		fmt.Fprintf(p.out, "%s:\n", heading)
+
+		for i, option := range options {
+			fmt.Fprintf(p.out, "  %d) %s\n", i+1, option)
+		}
+	}
+
+	hint := "number or name"
+	if len(options) == 0 {
+		hint = "name"
+	}
+
+	prompt := fmt.Sprintf("%s (%s, empty to skip): ", label, hint)
+	if defaultOption != "" {
+		prompt = fmt.Sprintf("%s (%s, - to skip) [%s]: ", label, hint, defaultOption)
+	}
+
+	for {
+		fmt.Fprint(p.out, prompt)
+
+		line, err := p.in.ReadString('\n')
+		if err != nil && err != io.EOF {
+			return "", err
+		}
+
+		answer := strings.TrimSpace(line)
+
+		switch answer {
+		case "":
+			return defaultOption, nil
+		case "-":
+			return "", nil
+		}
+
+		n, convErr := strconv.Atoi(answer)
+		if convErr != nil || len(options) == 0 {
+			return answer, nil
+		}
+
+		if n >= 1 && n <= len(options) {
+			return options[n-1], nil
+		}
+
+		if err == io.EOF {
+			return "", fmt.Errorf("%d is not an option", n)
+		}
+
+		fmt.Fprintf(p.out, "Enter a number from 1 to %d\n", len(options))
+	}
+}
+
+// asks a yes or no question, defaulting to no
+func (p *prompter) confirm(question string) (bool, error) {
+	fmt.Fprintf(p.out, "%s [y/N]: ", question)
+
+	line, err := p.in.ReadString('\n')
+	if err != nil && err != io.EOF {
+		return false, err
+	}
+
+	answer := strings.ToLower(strings.TrimSpace(line))
+
+	return answer == "y" || answer == "yes", nil
+}
@@ -0,0 +1,104 @@
+package cmd
+
+import (
+	"bufio"
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestPrompterChoose(t *testing.T) {
+	options := []string{"dev", "prod"}
+
+	tests := []struct {
+		answers       string
+		defaultOption string
+		expected      string
+	}{
+		{"2\n", "", "prod"},
+		{"qa\n", "", "qa"},
+		{"\n", "", ""},
+		{"\n", "dev", "dev"},
+		{"-\n", "dev", ""},
+		{"3\n1\n", "", "dev"},
+		{"", "prod", "prod"},
+	}
+
+	for _, test := range tests {
+		var out bytes.Buffer
+		p := &prompter{in: bufio.NewReader(strings.NewReader(test.answers)), out: &out}
+
+		choice, err := p.choose("Clusters", "Cluster", options, test.defaultOption)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		if choice != test.expected {
+			t.Errorf("Expected %s for %q, got %s", test.expected, test.answers, choice)
+		}
+
+		if !strings.Contains(out.String(), "  2) prod\n") {
+			t.Errorf("Expected options to be listed, got %s", out.String())
+		}
+	}
+
+	p := &prompter{in: bufio.NewReader(strings.NewReader("3")), out: &bytes.Buffer{}}
+
+	if _, err := p.choose("Clusters", "Cluster", options, ""); err == nil {
+		t.Errorf("Expected error, got nil")
+	}
+}
+
+func TestPrompterChooseWithoutOptions(t *testing.T) {
+	var out bytes.Buffer
+	p := &prompter{in: bufio.NewReader(strings.NewReader("1\n")), out: &out}
+
+	choice, err := p.choose("CloudWatch Events rules", "Rule", nil, "")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if choice != "1" {
+		t.Errorf("Expected 1, got %s", choice)
+	}
+
+	if !strings.HasPrefix(out.String(), "No cloudwatch events rules found\n") {
+		t.Errorf("Expected no rules found, got %s", out.String())
+	}
+}
+
+func TestPrompterConfirm(t *testing.T) {
+	tests := map[string]bool{
+		"y\n":   true,
+		"Yes\n": true,
+		"n\n":   false,
+		"\n":    false,
+		"":      false,
+	}
+
+	for answer, expected := range tests {
+		p := &prompter{in: bufio.NewReader(strings.NewReader(answer)), out: &bytes.Buffer{}}
+
+		confirmed, err := p.confirm("Write docker-compose.yml?")
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		if confirmed != expected {
+			t.Errorf("Expected %t for %q, got %t", expected, answer, confirmed)
+		}
+	}
+}
+
+func TestInitOperationSettings(t *testing.T) {
+	operation := InitOperation{Cluster: "dev", Task: "web", Region: "us-west-2"}
+	settings := operation.settings()
+
+	if len(settings) != 3 {
+		t.Fatalf("Expected 3 settings, got %d", len(settings))
+	}
+
+	if settings[0].Key != keyCluster || settings[1].Key != keyTask || settings[2].Key != keyRegion {
+		t.Errorf("Expected cluster, task and region, got %v", settings)
+	}
+}
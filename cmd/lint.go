@@ -0,0 +1,423 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	awsecs "github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/spf13/cobra"
+	"github.com/turnerlabs/fargate/console"
+	"github.com/turnerlabs/fargate/dockercompose"
+	ECS "github.com/turnerlabs/fargate/ecs"
+)
+
+const (
+	lintFormatText = "text"
+	lintFormatJSON = "json"
+
+	severityError   = "error"
+	severityWarning = "warning"
+
+	ruleSchema       = "schema"
+	ruleCpuMemory    = "cpu-memory"
+	ruleEnvName      = "env-name"
+	ruleEnvDuplicate = "env-duplicate"
+	ruleSecretArn    = "secret-arn"
+	rulePort         = "port"
+	rulePortConflict = "port-conflict"
+	ruleImage        = "image"
+	ruleDeployLabel  = "deploy-label"
+)
+
+// validImageReference matches docker image references: [registry[:port]/]name[:tag][@digest]
+var validImageReference = regexp.MustCompile(
+	`^(?:[a-zA-Z0-9](?:[a-zA-Z0-9-]*[a-zA-Z0-9])?(?:\.[a-zA-Z0-9](?:[a-zA-Z0-9-]*[a-zA-Z0-9])?)*(?::[0-9]+)?/)?` +
+		`[a-z0-9]+(?:(?:[._]|__|[-]*)[a-z0-9]+)*(?:/[a-z0-9]+(?:(?:[._]|__|[-]*)[a-z0-9]+)*)*` +
+		`(?::[\w][\w.-]{0,127})?` +
+		`(?:@[A-Za-z][A-Za-z0-9]*(?:[-_+.][A-Za-z][A-Za-z0-9]*)*:[0-9a-fA-F]{32,})?$`,
+)
+
+var validSsmParameterName = regexp.MustCompile(`^/?[a-zA-Z0-9_.\-/]+$`)
+var imageHasTag = regexp.MustCompile(`:[\w][\w.-]{0,127}$`)
+
+var flagLintDockerComposeFile string
+var flagLintTaskJSON string
+var flagLintFormat string
+
+// lintFinding is a single problem found while linting
+type lintFinding struct {
+	Severity string `json:"severity"`
+	Rule     string `json:"rule"`
+	Path     string `json:"path"`
+	Message  string `json:"message"`
+}
+
+// lintResult is the result of linting a single file
+type lintResult struct {
+	File     string        `json:"file"`
+	Errors   int           `json:"errors"`
+	Warnings int           `json:"warnings"`
+	Findings []lintFinding `json:"findings"`
+}
+
+type linter struct {
+	findings []lintFinding
+}
+
+func (l *linter) error(rule, path, msg string, a ...interface{}) {
+	l.findings = append(l.findings, lintFinding{Severity: severityError, Rule: rule, Path: path, Message: fmt.Sprintf(msg, a...)})
+}
+
+func (l *linter) warn(rule, path, msg string, a ...interface{}) {
+	l.findings = append(l.findings, lintFinding{Severity: severityWarning, Rule: rule, Path: path, Message: fmt.Sprintf(msg, a...)})
+}
+
+var lintCmd = &cobra.Command{
+	Use:   "lint [-f docker-compose.yml | --task-json file]",
+	Short: "Validate a docker compose file or task definition offline",
+	Long: `Validate a docker compose file or task definition offline
+
+Catches problems that would otherwise only show up when an AWS call fails in
+the middle of a deployment. No AWS credentials are required.
+
+The following rules are checked:
+
+  cpu-memory     cpu and memory are a valid Fargate combination
+  env-name       environment variable and secret names are valid identifiers
+  env-duplicate  environment variables and secrets are not defined twice
+  secret-arn     secrets reference an SSM parameter or Secrets Manager secret
+  port           port numbers are within 1 - 65535
+  port-conflict  containers do not listen on the same port
+  image          image references are valid
+  deploy-label   the container to deploy can be determined from a compose file
+
+The exit code is 1 if any errors are found. --format json returns the findings
+in a machine-readable format.`,
+	Example: `
+fargate lint -f docker-compose.yml
+fargate lint --task-json task-definition.json --format json
+`,
+	Run: func(cmd *cobra.Command, args []string) {
+		if (flagLintDockerComposeFile == "") == (flagLintTaskJSON == "") {
+			cmd.Help()
+			return
+		}
+
+		if flagLintFormat != lintFormatText && flagLintFormat != lintFormatJSON {
+			console.IssueExit("Invalid format %s (specify text or json)", flagLintFormat)
+		}
+
+		var result lintResult
+
+		if flagLintDockerComposeFile != "" {
+			result = lintDockerComposeFile(flagLintDockerComposeFile)
+		} else {
+			result = lintTaskJSONFile(flagLintTaskJSON)
+		}
+
+		printLintResult(result, flagLintFormat)
+
+		if result.Errors > 0 {
+			console.Exit(1)
+		}
+	},
+}
+
+func init() {
+	lintCmd.Flags().StringVarP(&flagLintDockerComposeFile, "file", "f", "", "Docker Compose file to validate")
+	lintCmd.Flags().StringVar(&flagLintTaskJSON, "task-json", "", "Task definition JSON file to validate (e.g. from task describe --format json)")
+	lintCmd.Flags().StringVar(&flagLintFormat, "format", lintFormatText, "Output format (text or json)")
+
+	rootCmd.AddCommand(lintCmd)
+}
+
+func lintDockerComposeFile(file string) lintResult {
+	composeFile, err := dockercompose.Read(file)
+	if err != nil {
+		return newLintResult(file, []lintFinding{{Severity: severityError, Rule: ruleSchema, Message: err.Error()}})
+	}
+
+	return newLintResult(file, lintDockerCompose(&composeFile.Data))
+}
+
+func lintTaskJSONFile(file string) lintResult {
+	bits, err := ioutil.ReadFile(file)
+	if err != nil {
+		console.ErrorExit(err, "Could not read %s", file)
+	}
+
+	input, err := ECS.UnmarshalTaskDefinitionInput(bits)
+	if err != nil {
+		return newLintResult(file, []lintFinding{{Severity: severityError, Rule: ruleSchema, Message: err.Error()}})
+	}
+
+	return newLintResult(file, lintTaskDefinition(input))
+}
+
+func newLintResult(file string, findings []lintFinding) lintResult {
+	result := lintResult{
+		File:     file,
+		Findings: findings,
+	}
+
+	if result.Findings == nil {
+		result.Findings = []lintFinding{}
+	}
+
+	for _, finding := range result.Findings {
+		if finding.Severity == severityError {
+			result.Errors++
+		} else {
+			result.Warnings++
+		}
+	}
+
+	return result
+}
+
+func printLintResult(result lintResult, format string) {
+	if format == lintFormatJSON {
+		bits, err := json.MarshalIndent(result, "", "  ")
+		if err != nil {
+			console.ErrorExit(err, "Could not marshal lint results")
+		}
+
+		fmt.Println(string(bits))
+		return
+	}
+
+	for _, finding := range result.Findings {
+		fmt.Printf("%s:%s: %s [%s] %s\n", result.File, finding.Path, finding.Severity, finding.Rule, finding.Message)
+	}
+
+	fmt.Printf("%d error(s), %d warning(s)\n", result.Errors, result.Warnings)
+}
+
+// lintDockerCompose checks all services in a docker compose file
+func lintDockerCompose(dc *dockercompose.DockerCompose) []lintFinding {
+	l := &linter{}
+
+	var names []string
+	for name := range dc.Services {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	labeled := 0
+	publishedPorts := make(map[int64]string)
+
+	// under awsvpc the containers of a task share a network namespace, so two
+	// services cannot listen on the same container port either
+	targetPorts := make(map[int64]string)
+
+	for _, name := range names {
+		service := dc.Services[name]
+		path := "services." + name
+
+		if service.Labels[deployDockerComposeLabel] == "1" {
+			labeled++
+		}
+
+		l.image(path+".image", service.Image)
+
+		var envNames []string
+		for key := range service.Environment {
+			envNames = append(envNames, key)
+		}
+		sort.Strings(envNames)
+
+		for _, key := range envNames {
+			l.envName(path+".environment."+key, key)
+		}
+
+		var secretNames []string
+		for key := range service.Secrets {
+			secretNames = append(secretNames, key)
+		}
+		sort.Strings(secretNames)
+
+		for _, key := range secretNames {
+			secretPath := path + ".x-fargate-secrets." + key
+
+			l.envName(secretPath, key)
+			l.secretArn(secretPath, service.Secrets[key])
+
+			if _, ok := service.Environment[key]; ok {
+				l.error(ruleEnvDuplicate, secretPath, "%s is defined as both an environment variable and a secret", key)
+			}
+		}
+
+		for _, port := range service.Ports {
+			portPath := fmt.Sprintf("%s.ports.%d", path, port.Target)
+
+			l.port(portPath, port.Target)
+
+			if other, ok := targetPorts[port.Target]; ok && other != name {
+				l.error(rulePortConflict, portPath, "container port %d is also used by %s", port.Target, other)
+			} else {
+				targetPorts[port.Target] = name
+			}
+
+			if port.PublishedAsInt == 0 {
+				continue
+			}
+
+			l.port(portPath, port.PublishedAsInt)
+
+			if other, ok := publishedPorts[port.PublishedAsInt]; ok {
+				l.error(rulePortConflict, portPath, "port %d is also published by %s", port.PublishedAsInt, other)
+			} else {
+				publishedPorts[port.PublishedAsInt] = name
+			}
+		}
+	}
+
+	if len(dc.Services) == 0 {
+		l.error(ruleDeployLabel, "services", "no services defined")
+	} else if _, service := getDockerServiceToDeploy(dc); service == nil {
+		l.error(ruleDeployLabel, "services", `more than one service is defined, indicate which one to deploy using the label "%s: 1"`, deployDockerComposeLabel)
+	} else if labeled > 1 {
+		l.error(ruleDeployLabel, "services", `only one service can be labeled with "%s: 1"`, deployDockerComposeLabel)
+	}
+
+	return l.findings
+}
+
+// lintTaskDefinition checks a task definition
+func lintTaskDefinition(input *awsecs.RegisterTaskDefinitionInput) []lintFinding {
+	l := &linter{}
+
+	cpu, memory := aws.StringValue(input.Cpu), aws.StringValue(input.Memory)
+	fargate := containsString(aws.StringValueSlice(input.RequiresCompatibilities), awsecs.CompatibilityFargate)
+
+	switch {
+	case cpu != "" && memory != "":
+		if err := validateCpuAndMemory(cpu, memory); err != nil {
+			l.error(ruleCpuMemory, "cpu", "invalid CPU and memory combination (%s CPU units / %s MiB)", cpu, memory)
+		}
+	case fargate:
+		l.error(ruleCpuMemory, "cpu", "cpu and memory are required for Fargate task definitions")
+	}
+
+	containerPorts := make(map[string]string)
+
+	for i, container := range input.ContainerDefinitions {
+		name := aws.StringValue(container.Name)
+		path := "containerDefinitions." + name
+
+		if name == "" {
+			path = fmt.Sprintf("containerDefinitions.%d", i)
+		}
+
+		l.image(path+".image", aws.StringValue(container.Image))
+
+		seen := make(map[string]bool)
+
+		for _, env := range container.Environment {
+			key := aws.StringValue(env.Name)
+			envPath := path + ".environment." + key
+
+			l.envName(envPath, key)
+
+			if seen[key] {
+				l.error(ruleEnvDuplicate, envPath, "%s is defined more than once", key)
+			}
+
+			seen[key] = true
+		}
+
+		for _, secret := range container.Secrets {
+			key := aws.StringValue(secret.Name)
+			secretPath := path + ".secrets." + key
+
+			l.envName(secretPath, key)
+			l.secretArn(secretPath, aws.StringValue(secret.ValueFrom))
+
+			if seen[key] {
+				l.error(ruleEnvDuplicate, secretPath, "%s is defined more than once", key)
+			}
+
+			seen[key] = true
+		}
+
+		for _, portMapping := range container.PortMappings {
+			containerPort := aws.Int64Value(portMapping.ContainerPort)
+			protocol := strings.ToLower(aws.StringValue(portMapping.Protocol))
+			if protocol == "" {
+				protocol = awsecs.TransportProtocolTcp
+			}
+
+			portPath := fmt.Sprintf("%s.portMappings.%d", path, containerPort)
+			l.port(portPath, containerPort)
+
+			if hostPort := aws.Int64Value(portMapping.HostPort); hostPort != 0 && hostPort != containerPort && aws.StringValue(input.NetworkMode) == awsecs.NetworkModeAwsvpc {
+				l.error(rulePort, portPath, "host port %d must match container port %d in awsvpc network mode", hostPort, containerPort)
+			}
+
+			key := fmt.Sprintf("%d/%s", containerPort, protocol)
+
+			if other, ok := containerPorts[key]; ok {
+				l.error(rulePortConflict, portPath, "port %s is also used by container %s", key, other)
+			} else {
+				containerPorts[key] = name
+			}
+		}
+	}
+
+	return l.findings
+}
+
+func (l *linter) image(path, image string) {
+	switch {
+	case image == "":
+		l.error(ruleImage, path, "image is required")
+	case !validImageReference.MatchString(image):
+		l.error(ruleImage, path, "invalid image reference %s", image)
+	case !strings.Contains(image, "@") && !imageHasTag.MatchString(image[strings.LastIndex(image, "/")+1:]):
+		l.warn(ruleImage, path, "image %s has no tag and will use latest", image)
+	}
+}
+
+func (l *linter) envName(path, key string) {
+	if err := validateEnvVarName(key); err != nil {
+		l.error(ruleEnvName, path, "%s", err)
+	}
+}
+
+func (l *linter) port(path string, number int64) {
+	for _, err := range validatePort(Port{Number: number, Protocol: protocolTcp}) {
+		l.error(rulePort, path, "%s", err)
+	}
+}
+
+// secrets must reference an SSM parameter (by arn or name) or a Secrets Manager secret
+func (l *linter) secretArn(path, valueFrom string) {
+	if !strings.HasPrefix(valueFrom, "arn:") {
+		if !validSsmParameterName.MatchString(valueFrom) {
+			l.error(ruleSecretArn, path, "%s is not a valid ARN or SSM parameter name", valueFrom)
+		}
+
+		return
+	}
+
+	parsed, err := arn.Parse(valueFrom)
+	if err != nil {
+		l.error(ruleSecretArn, path, "%s is not a valid ARN", valueFrom)
+		return
+	}
+
+	switch {
+	case parsed.Partition != "aws" && !strings.HasPrefix(parsed.Partition, "aws-"):
+		l.error(ruleSecretArn, path, "%s has an invalid partition %s", valueFrom, parsed.Partition)
+	case parsed.Service == "ssm" && strings.HasPrefix(parsed.Resource, "parameter/"):
+	case parsed.Service == "secretsmanager" && strings.HasPrefix(parsed.Resource, "secret:"):
+	default:
+		l.error(ruleSecretArn, path, "%s must reference an SSM parameter or a Secrets Manager secret", valueFrom)
+	}
+}
@@ -0,0 +1,177 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awsecs "github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/turnerlabs/fargate/dockercompose"
+)
+
+func findingRules(findings []lintFinding) []string {
+	var rules []string
+	for _, f := range findings {
+		rules = append(rules, f.Rule)
+	}
+	return rules
+}
+
+func TestLintTaskDefinition_Valid(t *testing.T) {
+	input := &awsecs.RegisterTaskDefinitionInput{
+		Cpu:                     aws.String("256"),
+		Memory:                  aws.String("512"),
+		NetworkMode:             aws.String(awsecs.NetworkModeAwsvpc),
+		RequiresCompatibilities: aws.StringSlice([]string{awsecs.CompatibilityFargate}),
+		ContainerDefinitions: []*awsecs.ContainerDefinition{
+			&awsecs.ContainerDefinition{
+				Name:  aws.String("app"),
+				Image: aws.String("123456789.dkr.ecr.us-east-1.amazonaws.com/my-app:0.1.0"),
+				Environment: []*awsecs.KeyValuePair{
+					&awsecs.KeyValuePair{Name: aws.String("FOO"), Value: aws.String("bar")},
+				},
+				Secrets: []*awsecs.Secret{
+					&awsecs.Secret{Name: aws.String("KEY"), ValueFrom: aws.String("arn:aws:ssm:us-east-1:000000000000:parameter/path/to/key")},
+					&awsecs.Secret{Name: aws.String("DB"), ValueFrom: aws.String("arn:aws:secretsmanager:us-east-1:000000000000:secret:db-AbCdEf")},
+					&awsecs.Secret{Name: aws.String("NAME"), ValueFrom: aws.String("/path/to/name")},
+				},
+				PortMappings: []*awsecs.PortMapping{
+					&awsecs.PortMapping{ContainerPort: aws.Int64(8080)},
+				},
+			},
+			&awsecs.ContainerDefinition{
+				Name:  aws.String("nginx"),
+				Image: aws.String("nginx:1.25@sha256:0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef"),
+				PortMappings: []*awsecs.PortMapping{
+					&awsecs.PortMapping{ContainerPort: aws.Int64(80)},
+				},
+			},
+		},
+	}
+
+	if findings := lintTaskDefinition(input); len(findings) != 0 {
+		t.Errorf("Expected no findings, got %v", findings)
+	}
+}
+
+func TestLintTaskDefinition_Invalid(t *testing.T) {
+	input := &awsecs.RegisterTaskDefinitionInput{
+		Cpu:    aws.String("256"),
+		Memory: aws.String("4096"),
+		ContainerDefinitions: []*awsecs.ContainerDefinition{
+			&awsecs.ContainerDefinition{
+				Name:  aws.String("app"),
+				Image: aws.String("My App:latest"),
+				Environment: []*awsecs.KeyValuePair{
+					&awsecs.KeyValuePair{Name: aws.String("1FOO"), Value: aws.String("bar")},
+					&awsecs.KeyValuePair{Name: aws.String("BAR"), Value: aws.String("baz")},
+				},
+				Secrets: []*awsecs.Secret{
+					&awsecs.Secret{Name: aws.String("BAR"), ValueFrom: aws.String("arn:key:ssm:us-east-1:000000000000:parameter/path")},
+				},
+				PortMappings: []*awsecs.PortMapping{
+					&awsecs.PortMapping{ContainerPort: aws.Int64(8080)},
+				},
+			},
+			&awsecs.ContainerDefinition{
+				Name:  aws.String("sidecar"),
+				Image: aws.String("sidecar"),
+				PortMappings: []*awsecs.PortMapping{
+					&awsecs.PortMapping{ContainerPort: aws.Int64(8080)},
+				},
+			},
+		},
+	}
+
+	expected := []string{ruleCpuMemory, ruleImage, ruleEnvName, ruleSecretArn, ruleEnvDuplicate, ruleImage, rulePortConflict}
+	got := findingRules(lintTaskDefinition(input))
+
+	if len(got) != len(expected) {
+		t.Fatalf("Expected %v, got %v", expected, got)
+	}
+
+	for i := range expected {
+		if got[i] != expected[i] {
+			t.Errorf("Expected %v, got %v", expected, got)
+			break
+		}
+	}
+}
+
+func TestLintDockerCompose(t *testing.T) {
+	yml := `
+version: "3.7"
+services:
+  web:
+    image: 1234567890.dkr.ecr.us-east-1.amazonaws.com/my-service:0.1.0
+    ports:
+    - "80:8080"
+    environment:
+      FOO: bar
+    x-fargate-secrets:
+      QUX: arn:aws:ssm:us-east-1:000000000000:parameter/path/to/my_parameter
+  redis:
+    image: redis:5
+    ports:
+    - "80:6379"
+`
+
+	compose, err := dockercompose.UnmarshalComposeYAML([]byte(yml))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []string{rulePortConflict, ruleDeployLabel}
+	got := findingRules(lintDockerCompose(&compose))
+
+	if len(got) != len(expected) {
+		t.Fatalf("Expected %v, got %v", expected, got)
+	}
+
+	for i := range expected {
+		if got[i] != expected[i] {
+			t.Errorf("Expected %v, got %v", expected, got)
+			break
+		}
+	}
+}
+
+func TestLintDockerComposeTargetPortConflict(t *testing.T) {
+	yml := `
+version: "3.7"
+services:
+  web:
+    image: 1234567890.dkr.ecr.us-east-1.amazonaws.com/my-service:0.1.0
+    labels:
+      aws.ecs.fargate.deploy: 1
+    ports:
+    - "80:8080"
+  worker:
+    image: 1234567890.dkr.ecr.us-east-1.amazonaws.com/my-worker:0.1.0
+    ports:
+    - "8000:8080"
+`
+
+	compose, err := dockercompose.UnmarshalComposeYAML([]byte(yml))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	findings := lintDockerCompose(&compose)
+
+	if len(findings) != 1 || findings[0].Rule != rulePortConflict || findings[0].Path != "services.worker.ports.8080" {
+		t.Errorf("Expected a port conflict on services.worker.ports.8080, got %v", findings)
+	}
+}
+
+func TestLintDockerComposeNoServices(t *testing.T) {
+	compose, err := dockercompose.UnmarshalComposeYAML([]byte(`version: "3.7"`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	findings := lintDockerCompose(&compose)
+
+	if len(findings) != 1 || findings[0].Rule != ruleDeployLabel || findings[0].Message != "no services defined" {
+		t.Errorf("Expected a single no services finding, got %v", findings)
+	}
+}
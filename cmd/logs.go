@@ -3,35 +3,156 @@ package cmd
 import (
 	"fmt"
 	"math/rand"
+	"sort"
 	"strings"
+	"sync"
 	"time"
 
-	lru "github.com/hashicorp/golang-lru"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/turnerlabs/fargate/awserrors"
 	CWL "github.com/turnerlabs/fargate/cloudwatchlogs"
 	"github.com/turnerlabs/fargate/console"
+	ECS "github.com/turnerlabs/fargate/ecs"
 )
 
 const (
-	timeFormat          = "2006-01-02 15:04:05"
-	timeFormatWithZone  = "2006-01-02 15:04:05 MST"
-	logStreamNameFormat = "fargate/%s/%s"
-	eventCacheSize      = 10000
+	timeFormat         = "2006-01-02 15:04:05"
+	timeFormatWithZone = "2006-01-02 15:04:05 MST"
 )
 
-type Empty struct{}
+var (
+	flagLogsServices          []string
+	flagLogsFilter            string
+	flagLogsEndTime           string
+	flagLogsStartTime         string
+	flagLogsFollow            bool
+	flagLogsTime              bool
+	flagLogsNoLogStreamPrefix bool
+	flagLogsContainers        []string
+	flagLogsAllContainers     bool
+	flagLogsJSONFields        []string
+	flagLogsLevel             string
+	flagLogsOutput            string
+	flagLogsSinceLast         bool
+	flagLogsGrep              string
+	flagLogsBeforeContext     int
+	flagLogsAfterContext      int
+	flagLogsInvert            bool
+)
+
+var logsCmd = &cobra.Command{
+	Use:   "logs",
+	Short: "Show logs from several services",
+	Long: `Show logs from several services
+
+Merges the logs of the services passed with --service (which can be specified
+multiple times) into one time-ordered view. Logs are prefixed by the name of
+their service, and by their container name if --container or --all-containers
+selects several containers. The service is read from fargate.yml or environment
+variable options if --service is not passed.
+
+The log group and log stream prefix of each service are read from the log
+configuration of its task definition, as with fargate service logs, which
+describes the remaining options.`,
+	Example: `
+fargate logs --service api --service worker --follow
+fargate logs --service api --service worker --start -1h --filter error
+fargate logs --service api --all-containers --follow --time
+`,
+	Annotations: map[string]string{annotationRequiresSession: "true"},
+	Run: func(cmd *cobra.Command, args []string) {
+		services := flagLogsServices
+		if len(services) == 0 {
+			services = []string{getServiceName()}
+		}
+
+		operation := &GetLogsOperation{
+			Filter:            flagLogsFilter,
+			Follow:            flagLogsFollow || flagLogsSinceLast,
+			SinceLast:         flagLogsSinceLast,
+			SaveCheckpoint:    true,
+			IncludeTime:       flagLogsTime,
+			NoLogStreamPrefix: flagLogsNoLogStreamPrefix,
+			JSONFields:        flagLogsJSONFields,
+			Output:            flagLogsOutput,
+			cwl:               CWL.New(sess),
+		}
+
+		operation.ValidateContainers(flagLogsContainers, flagLogsAllContainers)
+
+		for _, service := range services {
+			operation.AddServiceLogSources(service, flagLogsContainers, flagLogsAllContainers, true)
+		}
+
+		operation.AddStartTime(flagLogsStartTime)
+		operation.AddEndTime(flagLogsEndTime)
+		operation.AddLevelFilter(flagLogsLevel)
+		operation.AddGrep(flagLogsGrep, flagLogsBeforeContext, flagLogsAfterContext, flagLogsInvert)
+		operation.Validate()
+
+		GetLogs(operation)
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(logsCmd)
+
+	logsCmd.Flags().StringSliceVarP(&flagLogsServices, "service", "s", []string{}, "Show logs from a service (can be specified multiple times)")
+	logsCmd.Flags().BoolVarP(&flagLogsFollow, "follow", "f", false, "Poll logs and continuously print new events")
+	logsCmd.Flags().StringVar(&flagLogsFilter, "filter", "", "Filter pattern to apply")
+	logsCmd.Flags().StringVar(&flagLogsStartTime, "start", "", "Earliest time to return logs (e.g. -1h, 2018-01-01 09:36:00 EST")
+	logsCmd.Flags().StringVar(&flagLogsEndTime, "end", "", "Latest time to return logs (e.g. 3y, 2021-01-20 12:00:00 EST")
+	logsCmd.Flags().BoolVarP(&flagLogsTime, "time", "T", false, "append time to logs")
+	logsCmd.Flags().BoolVarP(&flagLogsNoLogStreamPrefix, "no-prefix", "", false, "don't include log stream prefix in output")
+	logsCmd.Flags().StringSliceVar(&flagLogsContainers, "container", []string{}, "Show logs from specific containers (e.g. app,nginx)")
+	logsCmd.Flags().BoolVar(&flagLogsAllContainers, "all-containers", false, "Show logs from all containers of the task definitions")
+	logsCmd.Flags().StringSliceVar(&flagLogsJSONFields, "json-fields", []string{}, "Render JSON messages as the given fields (e.g. level,msg,trace_id)")
+	logsCmd.Flags().StringVar(&flagLogsLevel, "level", "", "Only show JSON messages with this severity, or above with + (e.g. error+)")
+	logsCmd.Flags().StringVar(&flagLogsOutput, "output", logOutputText, "Output format (text or ndjson)")
+	logsCmd.Flags().BoolVar(&flagLogsSinceLast, "since-last", false, "Follow logs from where the last --follow of the same logs stopped")
+	logsCmd.Flags().StringVar(&flagLogsGrep, "grep", "", "Only show messages matching a regular expression")
+	logsCmd.Flags().IntVarP(&flagLogsBeforeContext, "before-context", "B", 0, "Show lines of the same stream before each --grep match")
+	logsCmd.Flags().IntVarP(&flagLogsAfterContext, "after-context", "A", 0, "Show lines of the same stream after each --grep match")
+	logsCmd.Flags().BoolVar(&flagLogsInvert, "invert", false, "Only show messages not matching --grep")
+}
+
+// LogSource is a log group, and the log streams of a container within it, to
+// get logs from. The name labels its logs when several sources are merged.
+type LogSource struct {
+	Name            string
+	LogGroupName    string
+	LogStreamPrefix string
+	LogStreamNames  []string
+
+	//the service whose tasks log to the source, if the cluster is configured;
+	//follow mode finds the streams of new tasks by listing the tasks of the
+	//service rather than the log streams
+	ServiceName string
+}
 
 type GetLogsOperation struct {
-	LogGroupName      string
-	Namespace         string
+	Sources           []LogSource
 	EndTime           time.Time
 	Filter            string
 	Follow            bool
 	LogStreamColors   map[string]int
-	LogStreamNames    []string
 	StartTime         time.Time
-	EventCache        *lru.Cache
 	IncludeTime       bool
 	NoLogStreamPrefix bool
+	StopFollowing     func() bool
+	SaveCheckpoint    bool
+	SinceLast         bool
+	Tail              int
+	JSONFields        []string
+	LevelFilter       *logLevelFilter
+	Grep              *logGrep
+	Output            string
+
+	//the ECS client is created for the configured cluster when first needed
+	//unless one is given
+	ecs *ECS.ECS
+	cwl CWL.CloudWatchLogs
 }
 
 func (o *GetLogsOperation) AddStartTime(rawStartTime string) {
@@ -46,10 +167,40 @@ func (o *GetLogsOperation) AddEndTime(rawEndTime string) {
 	}
 }
 
+func (o *GetLogsOperation) AddLogSource(name string, logConfiguration ECS.LogConfiguration) {
+	o.Sources = append(o.Sources,
+		LogSource{
+			Name:            name,
+			LogGroupName:    logConfiguration.LogGroupName,
+			LogStreamPrefix: logConfiguration.LogStreamPrefix(),
+		},
+	)
+}
+
 func (o *GetLogsOperation) AddTasks(tasks []string) {
-	for _, task := range tasks {
-		logStreamName := fmt.Sprintf(logStreamNameFormat, o.Namespace, task)
-		o.LogStreamNames = append(o.LogStreamNames, logStreamName)
+	for i := range o.Sources {
+		for _, task := range tasks {
+			o.Sources[i].LogStreamNames = append(o.Sources[i].LogStreamNames, o.Sources[i].LogStreamPrefix+task)
+		}
+	}
+}
+
+func (o *GetLogsOperation) AddLevelFilter(rawLevel string) {
+	if rawLevel == "" {
+		return
+	}
+
+	filter, err := parseLogLevelFilter(rawLevel)
+	if err != nil {
+		console.ErrorExit(err, "Invalid command line flags")
+	}
+
+	o.LevelFilter = filter
+}
+
+func (o *GetLogsOperation) ValidateContainers(containerNames []string, allContainers bool) {
+	if allContainers && len(containerNames) > 0 {
+		console.ErrorExit(fmt.Errorf("--container and --all-containers cannot be used together"), "Invalid command line flags")
 	}
 }
 
@@ -57,6 +208,14 @@ func (o *GetLogsOperation) Validate() {
 	if o.Follow && !o.EndTime.IsZero() {
 		console.ErrorExit(fmt.Errorf("--end-time cannot be specified if following"), "Invalid command line flags")
 	}
+
+	if o.SinceLast && !o.StartTime.IsZero() {
+		console.ErrorExit(fmt.Errorf("--since-last and --start cannot be used together"), "Invalid command line flags")
+	}
+
+	if o.Output != "" && o.Output != logOutputText && o.Output != logOutputNDJSON {
+		console.ErrorExit(fmt.Errorf("--output must be text or ndjson"), "Invalid command line flags")
+	}
 }
 
 func (o *GetLogsOperation) GetStreamColor(logStreamName string) int {
@@ -71,20 +230,12 @@ func (o *GetLogsOperation) GetStreamColor(logStreamName string) int {
 	return o.LogStreamColors[logStreamName]
 }
 
-func (o *GetLogsOperation) SeenEvent(eventId string) bool {
-	if o.EventCache == nil {
-		o.EventCache, _ = lru.New(eventCacheSize)
-	}
-
-	if !o.EventCache.Contains(eventId) {
-		o.EventCache.Add(eventId, Empty{})
-		return false
-	} else {
-		return true
-	}
+func (o *GetLogsOperation) parseTime(rawTime string) time.Time {
+	return parseTimeExpression(rawTime)
 }
 
-func (o *GetLogsOperation) parseTime(rawTime string) time.Time {
+// parses a duration relative to now or a timestamp
+func parseTimeExpression(rawTime string) time.Time {
 	var t time.Time
 
 	if duration, err := time.ParseDuration(strings.ToLower(rawTime)); err == nil {
@@ -115,34 +266,23 @@ func GetLogs(operation *GetLogsOperation) {
 }
 
 func followLogs(operation *GetLogsOperation) {
-	ticker := time.NewTicker(time.Second)
-
-	if operation.StartTime.IsZero() {
-		operation.StartTime = time.Now()
-	}
-
-	for {
-		getLogs(operation)
-
-		if newStartTime := time.Now().Add(-10 * time.Second); newStartTime.After(operation.StartTime) {
-			operation.StartTime = newStartTime
-		}
+	newLogFollower(operation).follow()
+}
 
-		<-ticker.C
-	}
+// a log line and the name of the source it was read from
+type sourceLogLine struct {
+	CWL.LogLine
+	Source string
 }
 
 func getLogs(operation *GetLogsOperation) {
-	cwl := CWL.New(sess)
-	input := &CWL.GetLogsInput{
-		LogStreamNames: operation.LogStreamNames,
-		LogGroupName:   operation.LogGroupName,
-		Filter:         operation.Filter,
-		StartTime:      operation.StartTime,
-		EndTime:        operation.EndTime,
+	logLines := fetchLogs(operation)
+
+	if operation.Tail > 0 && len(logLines) > operation.Tail {
+		logLines = logLines[len(logLines)-operation.Tail:]
 	}
 
-	for _, logLine := range cwl.GetLogs(input) {
+	for _, logLine := range logLines {
 
 		//format time if needed
 		var logTime string
@@ -151,10 +291,242 @@ func getLogs(operation *GetLogsOperation) {
 		}
 
 		// logLine.Timestamp
-		streamColor := operation.GetStreamColor(logLine.LogStreamName)
+		streamColor := operation.GetStreamColor(logLine.Source + logLine.LogStreamName)
+
+		operation.printLogLine(logLine.Source, logLine.LogLine, streamColor, logTime)
+	}
+}
+
+// fetches the logs of all sources concurrently and merges them in time order
+func fetchLogs(operation *GetLogsOperation) []sourceLogLine {
+	results := make([][]CWL.LogLine, len(operation.Sources))
+
+	var wg sync.WaitGroup
+
+	for i, source := range operation.Sources {
+		input := &CWL.GetLogsInput{
+			LogStreamNames: source.LogStreamNames,
+			LogGroupName:   source.LogGroupName,
+			Filter:         operation.Filter,
+			StartTime:      operation.StartTime,
+			EndTime:        operation.EndTime,
+		}
 
-		if !operation.SeenEvent(logLine.EventId) {
-			console.LogLine(logLine.LogStreamName, logLine.Message, streamColor, logTime, operation.NoLogStreamPrefix)
+		if len(source.LogStreamNames) == 0 {
+			input.LogStreamNamePrefix = source.LogStreamPrefix
 		}
+
+		wg.Add(1)
+
+		go func(i int, input *CWL.GetLogsInput) {
+			defer wg.Done()
+
+			logLines, err := operation.cwl.FilterLogEvents(input)
+
+			//the log streams of tasks that failed to start do not exist
+			if err != nil && !(awserrors.IsNotFound(err) && len(input.LogStreamNames) > 0) {
+				console.ErrorExit(err, "Could not get logs for: "+input.LogGroupName)
+			}
+
+			results[i] = logLines
+		}(i, input)
 	}
+
+	wg.Wait()
+
+	var logLines []sourceLogLine
+
+	for i, result := range results {
+		for _, logLine := range result {
+			logLines = append(logLines, sourceLogLine{LogLine: logLine, Source: operation.Sources[i].Name})
+		}
+	}
+
+	return mergeLogLines(logLines)
+}
+
+// orders log lines from several sources by time, keeping the order of lines
+// with the same timestamp
+func mergeLogLines(logLines []sourceLogLine) []sourceLogLine {
+	sort.SliceStable(logLines, func(i, j int) bool {
+		return logLines[i].Timestamp.Before(logLines[j].Timestamp)
+	})
+
+	return logLines
+}
+
+// adds the log sources of containers of a service to an operation; sources are
+// labelled with the service name if labelService is set, and with the container
+// name if there are several containers
+func (o *GetLogsOperation) AddServiceLogSources(serviceName string, containerNames []string, allContainers, labelService bool) {
+	logConfigurations := getServiceLogConfigurations(o.ecs, serviceName, containerNames, allContainers)
+
+	for _, logConfiguration := range logConfigurations {
+		var labels []string
+
+		if labelService {
+			labels = append(labels, serviceName)
+		}
+
+		if len(logConfigurations) > 1 {
+			labels = append(labels, logConfiguration.ContainerName)
+		}
+
+		o.AddLogSource(strings.Join(labels, "/"), logConfiguration)
+
+		if viper.GetString(keyCluster) != "" {
+			o.Sources[len(o.Sources)-1].ServiceName = serviceName
+		}
+	}
+}
+
+// returns the names of all services of the configured cluster
+func getClusterServiceNames() []string {
+	var services []string
+
+	ecs := ECS.New(sess, getClusterName())
+
+	ecsServices, err := ecs.ListServices()
+	if err != nil {
+		console.ErrorExit(err, "Could not list ECS services")
+	}
+
+	for _, service := range ecsServices {
+		services = append(services, service.Name)
+	}
+
+	return services
+}
+
+// returns the distinct log groups of the containers of a service
+func getServiceLogGroupNames(serviceName string) []string {
+	var logGroupNames []string
+
+	for _, logConfiguration := range getServiceLogConfigurations(nil, serviceName, []string{}, true) {
+		if posString(logGroupNames, logConfiguration.LogGroupName) == -1 {
+			logGroupNames = append(logGroupNames, logConfiguration.LogGroupName)
+		}
+	}
+
+	return logGroupNames
+}
+
+// returns the log configuration of the first container of a service
+func getServiceLogConfiguration(serviceName string) ECS.LogConfiguration {
+	return getServiceLogConfigurations(nil, serviceName, []string{}, false)[0]
+}
+
+// returns the log configurations of containers of a service (all of them, the
+// named ones, or the first container) from its task definition. If the cluster
+// is not configured, the /fargate/service/<service> convention is assumed. If
+// no ECS client is given, one is created for the configured cluster.
+func getServiceLogConfigurations(ecs *ECS.ECS, serviceName string, containerNames []string, allContainers bool) []ECS.LogConfiguration {
+	var logConfigurations []ECS.LogConfiguration
+
+	logGroupName := fmt.Sprintf(serviceLogGroupFormat, serviceName)
+
+	if viper.GetString(keyCluster) == "" {
+		if len(containerNames) == 0 {
+			containerNames = []string{serviceName}
+		}
+
+		for _, containerName := range containerNames {
+			logConfigurations = append(logConfigurations,
+				ECS.LogConfiguration{
+					ContainerName: containerName,
+					LogGroupName:  logGroupName,
+					StreamPrefix:  ECS.DefaultLogStreamPrefix,
+				},
+			)
+		}
+
+		return logConfigurations
+	}
+
+	ecs = ecsOrDefault(ecs)
+
+	service, err := ecs.DescribeService(serviceName)
+	if err != nil {
+		console.ErrorExit(err, "Could not describe ECS service")
+	}
+
+	dtd, err := ecs.DescribeTaskDefinition(service.TaskDefinitionArn)
+	if err != nil {
+		console.ErrorExit(err, "Could not describe ECS task definition")
+	}
+
+	taskDefinition := dtd.TaskDefinition
+
+	logConfigurations, err = selectContainerLogConfigurations(
+		ECS.GetLogConfigurations(taskDefinition, logGroupName),
+		containerNames,
+		allContainers,
+	)
+
+	if err != nil {
+		console.ErrorExit(err, "Could not select containers of service %s", serviceName)
+	}
+
+	return logConfigurations
+}
+
+// returns the given ECS client, or one for the configured cluster if none is
+// given; the cluster is only required once a client is needed
+func ecsOrDefault(ecs *ECS.ECS) *ECS.ECS {
+	if ecs != nil {
+		return ecs
+	}
+
+	client := ECS.New(sess, getClusterName())
+
+	return &client
+}
+
+// selects the log configurations of the named containers, or all of them; the
+// first container is selected if none are named
+func selectContainerLogConfigurations(logConfigurations []ECS.LogConfiguration, containerNames []string, all bool) ([]ECS.LogConfiguration, error) {
+	if len(logConfigurations) == 0 {
+		return nil, fmt.Errorf("no containers found")
+	}
+
+	if all {
+		return logConfigurations, nil
+	}
+
+	if len(containerNames) == 0 {
+		return logConfigurations[:1], nil
+	}
+
+	var available []string
+	for _, logConfiguration := range logConfigurations {
+		available = append(available, logConfiguration.ContainerName)
+	}
+
+	var selected []ECS.LogConfiguration
+
+	for _, containerName := range containerNames {
+		pos := posString(available, containerName)
+		if pos == -1 {
+			return nil, fmt.Errorf("container %s not found (available: %s)", containerName, strings.Join(available, ", "))
+		}
+
+		selected = append(selected, logConfigurations[pos])
+	}
+
+	return selected, nil
+}
+
+// returns the log configuration of a container of a task family (the first
+// container if no name is given) from its latest task definition
+func getTaskLogConfiguration(family, containerName string) ECS.LogConfiguration {
+	ecs := ECS.New(sess, "")
+
+	dtd, err := ecs.DescribeTaskDefinition(family)
+	if err != nil {
+		console.ErrorExit(err, "Could not describe ECS task definition")
+	}
+
+	taskDefinition := dtd.TaskDefinition
+
+	return ECS.GetContainerLogConfiguration(taskDefinition, containerName, fmt.Sprintf(taskLogGroupFormat, family))
 }
@@ -0,0 +1,432 @@
+package cmd
+
+import (
+	"bufio"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/turnerlabs/fargate/awserrors"
+	CWL "github.com/turnerlabs/fargate/cloudwatchlogs"
+	"github.com/turnerlabs/fargate/console"
+)
+
+const (
+	exportProgressFile       = ".fargate-export.json"
+	exportMaxAttempts        = 8
+	exportInitialBackoff     = 500 * time.Millisecond
+	defaultExportConcurrency = 4
+)
+
+type LogsExportOperation struct {
+	LogGroupName    string
+	LogStreamPrefix string
+	TaskIds         []string
+	StartTime       time.Time
+	EndTime         time.Time
+	OutputDirectory string
+	Gzip            bool
+	Concurrency     int
+	Restart         bool
+}
+
+func (o *LogsExportOperation) SetTimeRange(rawStartTime, rawEndTime string) {
+	o.StartTime = parseTimeExpression(rawStartTime)
+	o.EndTime = time.Now()
+
+	if rawEndTime != "" {
+		o.EndTime = parseTimeExpression(rawEndTime)
+	}
+}
+
+func (o *LogsExportOperation) Validate() {
+	if o.OutputDirectory == "" {
+		console.IssueExit("--out is required")
+	}
+
+	if !o.EndTime.After(o.StartTime) {
+		console.IssueExit("--end must be after --start")
+	}
+
+	if o.Concurrency < 1 {
+		console.IssueExit("--concurrency must be at least 1")
+	}
+}
+
+// exportProgress records which events have been exported so that an
+// interrupted export can be resumed
+type exportProgress struct {
+	LogGroupName string                           `json:"logGroupName"`
+	StartTime    time.Time                        `json:"startTime"`
+	EndTime      time.Time                        `json:"endTime"`
+	Gzip         bool                             `json:"gzip"`
+	Streams      map[string]*exportStreamProgress `json:"streams"`
+
+	path string
+	mu   sync.Mutex
+}
+
+type exportStreamProgress struct {
+	File     string `json:"file"`
+	Events   int64  `json:"events"`
+	Complete bool   `json:"complete"`
+
+	// the size of the file once the exported events were written; anything
+	// after it was written after the progress was last recorded
+	Offset int64 `json:"offset"`
+
+	// the timestamp (in ms) of the last exported event and the number of
+	// exported events with that timestamp
+	LastTimestamp         int64 `json:"lastTimestamp"`
+	EventsAtLastTimestamp int   `json:"eventsAtLastTimestamp"`
+}
+
+// drops the events at the start of a page that were already read with the
+// given timestamp, e.g. before an export was resumed
+func skipSeenEvents(logLines []CWL.LogLine, timestamp int64, skip *int) []CWL.LogLine {
+	for len(logLines) > 0 && *skip > 0 {
+		if toMilliseconds(logLines[0].Timestamp) != timestamp {
+			*skip = 0
+			break
+		}
+
+		logLines = logLines[1:]
+		*skip--
+	}
+
+	return logLines
+}
+
+// records exported events
+func (p *exportStreamProgress) advance(logLines []CWL.LogLine) {
+	for _, logLine := range logLines {
+		timestamp := toMilliseconds(logLine.Timestamp)
+
+		if timestamp == p.LastTimestamp {
+			p.EventsAtLastTimestamp++
+		} else {
+			p.LastTimestamp = timestamp
+			p.EventsAtLastTimestamp = 1
+		}
+
+		p.Events++
+	}
+}
+
+func toMilliseconds(t time.Time) int64 {
+	return t.UTC().UnixNano() / int64(time.Millisecond)
+}
+
+// loads the progress of a previous export into the output directory, or starts a new one
+func loadExportProgress(op *LogsExportOperation) *exportProgress {
+	path := filepath.Join(op.OutputDirectory, exportProgressFile)
+
+	if !op.Restart {
+		if bits, err := ioutil.ReadFile(path); err == nil {
+			progress := &exportProgress{}
+
+			if err := json.Unmarshal(bits, progress); err != nil {
+				console.ErrorExit(err, "Could not read export progress from %s (use --restart to start over)", path)
+			}
+
+			if progress.LogGroupName != op.LogGroupName {
+				console.IssueExit("%s contains an export of %s (use --restart to start over)", op.OutputDirectory, progress.LogGroupName)
+			}
+
+			console.Info("Resuming export of %s from %s to %s", progress.LogGroupName, progress.StartTime.Format(timeFormatWithZone), progress.EndTime.Format(timeFormatWithZone))
+
+			progress.path = path
+
+			return progress
+		}
+	}
+
+	return &exportProgress{
+		LogGroupName: op.LogGroupName,
+		StartTime:    op.StartTime,
+		EndTime:      op.EndTime,
+		Gzip:         op.Gzip,
+		Streams:      make(map[string]*exportStreamProgress),
+		path:         path,
+	}
+}
+
+func (p *exportProgress) stream(logStreamName string) exportStreamProgress {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if stream, ok := p.Streams[logStreamName]; ok {
+		return *stream
+	}
+
+	return exportStreamProgress{}
+}
+
+func (p *exportProgress) update(logStreamName string, stream exportStreamProgress) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.Streams[logStreamName] = &stream
+
+	bits, err := json.MarshalIndent(p, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	// write and rename so an interrupted write does not corrupt the progress
+	tmp := p.path + ".tmp"
+	if err := ioutil.WriteFile(tmp, bits, 0644); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp, p.path)
+}
+
+func exportLogs(op *LogsExportOperation) {
+	if err := os.MkdirAll(op.OutputDirectory, 0755); err != nil {
+		console.ErrorExit(err, "Could not create %s", op.OutputDirectory)
+	}
+
+	progress := loadExportProgress(op)
+	cwl := CWL.New(sess)
+
+	allLogStreams, err := cwl.DescribeLogStreams(progress.LogGroupName, op.LogStreamPrefix)
+	if err != nil {
+		console.ErrorExit(err, "Could not list log streams for: "+progress.LogGroupName)
+	}
+
+	var logStreams []CWL.LogStream
+
+	for _, logStream := range allLogStreams {
+		if len(op.TaskIds) > 0 && !containsString(op.TaskIds, logStreamTaskId(logStream.Name)) {
+			continue
+		}
+
+		if logStream.LastEventTime.Before(progress.StartTime) || logStream.FirstEventTime.After(progress.EndTime) {
+			continue
+		}
+
+		logStreams = append(logStreams, logStream)
+	}
+
+	if len(logStreams) == 0 {
+		console.InfoExit("No log streams found between %s and %s", progress.StartTime.Format(timeFormatWithZone), progress.EndTime.Format(timeFormatWithZone))
+	}
+
+	console.Info("Exporting %d log streams from %s to %s", len(logStreams), progress.LogGroupName, op.OutputDirectory)
+
+	var wg sync.WaitGroup
+	var failedMu sync.Mutex
+
+	failed := 0
+
+	streams := make(chan CWL.LogStream)
+
+	for i := 0; i < op.Concurrency; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for logStream := range streams {
+				if err := exportLogStream(&cwl, progress, logStream.Name, op.OutputDirectory); err != nil {
+					console.Error(err, "Could not export %s", logStream.Name)
+
+					failedMu.Lock()
+					failed++
+					failedMu.Unlock()
+				}
+			}
+		}()
+	}
+
+	for _, logStream := range logStreams {
+		streams <- logStream
+	}
+
+	close(streams)
+	wg.Wait()
+
+	if failed > 0 {
+		console.IssueExit("%d of %d log streams could not be exported, run the command again to resume", failed, len(logStreams))
+	}
+
+	console.Info("Exported %d log streams to %s", len(logStreams), op.OutputDirectory)
+}
+
+// returns the name of the file to export a log stream named
+// <prefix>/<container>/<task-id> to: <task-id>-<container>.log, as the tasks of
+// a service can have several containers
+func exportFileName(logStreamName string, gzip bool) string {
+	file := logStreamTaskId(logStreamName)
+
+	parts := strings.Split(logStreamName, "/")
+	if len(parts) > 1 && parts[len(parts)-2] != "" {
+		file += "-" + parts[len(parts)-2]
+	}
+
+	file += ".log"
+	if gzip {
+		file += ".gz"
+	}
+
+	return file
+}
+
+// exports the events of a log stream to a file named after its task and container
+func exportLogStream(cwl *CWL.CloudWatchLogs, progress *exportProgress, logStreamName, directory string) error {
+	stream := progress.stream(logStreamName)
+
+	if stream.Complete {
+		console.Debug("Skipping %s (already exported)", logStreamName)
+		return nil
+	}
+
+	// resumed streams keep appending to the file they were exported to
+	if stream.File == "" {
+		stream.File = exportFileName(logStreamName, progress.Gzip)
+	}
+
+	// progress recorded without the size of the file cannot be resumed safely
+	if stream.Offset == 0 {
+		stream = exportStreamProgress{File: stream.File}
+	}
+
+	file, err := openExportFile(filepath.Join(directory, stream.File), stream.Offset)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	input := &CWL.GetLogEventsInput{
+		LogGroupName:  progress.LogGroupName,
+		LogStreamName: logStreamName,
+		StartTime:     progress.StartTime,
+		EndTime:       progress.EndTime,
+	}
+
+	var skip int
+
+	if stream.LastTimestamp > 0 {
+		input.StartTime = time.Unix(0, stream.LastTimestamp*int64(time.Millisecond))
+		skip = stream.EventsAtLastTimestamp
+	}
+
+	for {
+		output, err := getLogEventsPageWithRetry(cwl, input)
+		if err != nil {
+			return err
+		}
+
+		logLines := skipSeenEvents(output.LogLines, stream.LastTimestamp, &skip)
+
+		// only record progress once the events have been written
+		if err := writeExportPage(file, logLines, progress.Gzip); err != nil {
+			return err
+		}
+
+		if stream.Offset, err = file.Seek(0, io.SeekCurrent); err != nil {
+			return err
+		}
+
+		stream.advance(logLines)
+
+		if output.NextToken == "" {
+			break
+		}
+
+		if err := progress.update(logStreamName, stream); err != nil {
+			return err
+		}
+
+		input.NextToken = output.NextToken
+	}
+
+	stream.Complete = true
+
+	if err := progress.update(logStreamName, stream); err != nil {
+		return err
+	}
+
+	console.Info("Exported %d events from %s to %s", stream.Events, logStreamName, stream.File)
+
+	return nil
+}
+
+// opens an export file for writing at an offset, dropping anything written
+// after it, e.g. by an export that was interrupted before recording its progress
+func openExportFile(path string, offset int64) (*os.File, error) {
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := file.Truncate(offset); err != nil {
+		file.Close()
+		return nil, err
+	}
+
+	if _, err := file.Seek(offset, io.SeekStart); err != nil {
+		file.Close()
+		return nil, err
+	}
+
+	return file, nil
+}
+
+// writes a page of events, one per line. With gzip, each page is a complete
+// gzip member, so a file truncated to the end of a page can be appended to and
+// read as a whole.
+func writeExportPage(w io.Writer, logLines []CWL.LogLine, gzipped bool) error {
+	if len(logLines) == 0 {
+		return nil
+	}
+
+	var gzipWriter *gzip.Writer
+
+	if gzipped {
+		gzipWriter = gzip.NewWriter(w)
+		w = gzipWriter
+	}
+
+	buffer := bufio.NewWriter(w)
+
+	for _, logLine := range logLines {
+		fmt.Fprintf(buffer, "%s %s\n", logLine.Timestamp.UTC().Format(time.RFC3339Nano), strings.TrimRight(logLine.Message, "\n"))
+	}
+
+	if err := buffer.Flush(); err != nil {
+		return err
+	}
+
+	if gzipWriter != nil {
+		return gzipWriter.Close()
+	}
+
+	return nil
+}
+
+// gets a page of log events, backing off and retrying when throttled
+func getLogEventsPageWithRetry(cwl *CWL.CloudWatchLogs, input *CWL.GetLogEventsInput) (CWL.GetLogEventsOutput, error) {
+	backoff := exportInitialBackoff
+
+	for attempt := 1; ; attempt++ {
+		output, err := cwl.GetLogEventsPage(input)
+
+		if err == nil || !awserrors.IsThrottled(err) || attempt == exportMaxAttempts {
+			return output, err
+		}
+
+		console.Debug("Throttled fetching %s, retrying in %s", input.LogStreamName, backoff)
+
+		time.Sleep(backoff)
+		backoff *= 2
+	}
+}
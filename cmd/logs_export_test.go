@@ -0,0 +1,132 @@
+package cmd
+
+import (
+	"compress/gzip"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	CWL "github.com/turnerlabs/fargate/cloudwatchlogs"
+)
+
+func logLinesAt(timestamps ...int64) []CWL.LogLine {
+	var logLines []CWL.LogLine
+
+	for _, ts := range timestamps {
+		logLines = append(logLines, CWL.LogLine{Timestamp: time.Unix(0, ts*int64(time.Millisecond))})
+	}
+
+	return logLines
+}
+
+func TestExportStreamProgress(t *testing.T) {
+	stream := exportStreamProgress{}
+	stream.advance(logLinesAt(1000, 2000, 2000))
+
+	if stream.LastTimestamp != 2000 || stream.EventsAtLastTimestamp != 2 || stream.Events != 3 {
+		t.Errorf("Unexpected progress %+v", stream)
+	}
+
+	// resuming at 2000 must skip the two events already exported, even across an empty page
+	skip := stream.EventsAtLastTimestamp
+
+	if got := skipSeenEvents(logLinesAt(), stream.LastTimestamp, &skip); len(got) != 0 || skip != 2 {
+		t.Errorf("Expected an empty page to keep skipping, got %d events and skip %d", len(got), skip)
+	}
+
+	got := skipSeenEvents(logLinesAt(2000, 2000, 2000, 3000), stream.LastTimestamp, &skip)
+	if len(got) != 2 || toMilliseconds(got[0].Timestamp) != 2000 || skip != 0 {
+		t.Errorf("Expected [2000 3000], got %d events and skip %d", len(got), skip)
+	}
+
+	stream.advance(got)
+
+	if stream.LastTimestamp != 3000 || stream.EventsAtLastTimestamp != 1 || stream.Events != 5 {
+		t.Errorf("Unexpected progress %+v", stream)
+	}
+}
+
+func TestSkipExported_NewTimestamp(t *testing.T) {
+	skip := 3
+
+	got := skipSeenEvents(logLinesAt(2000, 5000), 2000, &skip)
+	if len(got) != 1 || skip != 0 {
+		t.Errorf("Expected skipping to stop at a new timestamp, got %d events and skip %d", len(got), skip)
+	}
+}
+
+func TestExportFileName(t *testing.T) {
+	tests := map[string]string{
+		"fargate/app/0123456789abcdef":     "0123456789abcdef-app.log",
+		"fargate/sidecar/0123456789abcdef": "0123456789abcdef-sidecar.log",
+		"0123456789abcdef":                 "0123456789abcdef.log",
+	}
+
+	for logStreamName, expected := range tests {
+		if got := exportFileName(logStreamName, false); got != expected {
+			t.Errorf("Expected %s, got %s", expected, got)
+		}
+	}
+
+	if got := exportFileName("fargate/app/0123456789abcdef", true); got != "0123456789abcdef-app.log.gz" {
+		t.Errorf("Expected 0123456789abcdef-app.log.gz, got %s", got)
+	}
+}
+
+func TestExportResumeGzip(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "export.log.gz")
+
+	file, err := openExportFile(path, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := writeExportPage(file, logLinesAt(1000), true); err != nil {
+		t.Fatal(err)
+	}
+
+	offset, err := file.Seek(0, io.SeekCurrent)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// an interrupted export leaves a partial page that was not recorded
+	if _, err := file.Write([]byte{0x1f, 0x8b, 0x08}); err != nil {
+		t.Fatal(err)
+	}
+	file.Close()
+
+	file, err = openExportFile(path, offset)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := writeExportPage(file, logLinesAt(2000, 3000), true); err != nil {
+		t.Fatal(err)
+	}
+	file.Close()
+
+	compressed, err := os.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer compressed.Close()
+
+	reader, err := gzip.NewReader(compressed)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bits, err := ioutil.ReadAll(reader)
+	if err != nil {
+		t.Fatalf("Expected the resumed file to be readable, got %v", err)
+	}
+
+	if lines := strings.Split(strings.TrimSpace(string(bits)), "\n"); len(lines) != 3 {
+		t.Errorf("Expected 3 lines, got %v", lines)
+	}
+}
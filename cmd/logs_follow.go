@@ -0,0 +1,551 @@
+package cmd
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	awsecs "github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/turnerlabs/fargate/awserrors"
+	CWL "github.com/turnerlabs/fargate/cloudwatchlogs"
+	"github.com/turnerlabs/fargate/console"
+)
+
+const (
+	followPollInterval       = time.Second
+	followMaxPollInterval    = 10 * time.Second
+	followMaxBackoff         = time.Minute
+	followConcurrency        = 4
+	logStreamRefreshInterval = 15 * time.Second
+	logStreamActivityWindow  = time.Hour
+	logsCheckpointDirectory  = "fargate/logs"
+)
+
+// logStreamCursor is the position of follow mode in a log stream, so that each
+// poll only returns events that have not been read yet
+type logStreamCursor struct {
+	Source        string
+	LogGroupName  string
+	LogStreamName string
+	StartTime     time.Time
+	NextToken     string
+
+	// the timestamp (in ms) of the last read event and the number of read
+	// events with that timestamp
+	LastTimestamp         int64
+	EventsAtLastTimestamp int
+
+	interval time.Duration
+	nextPoll time.Time
+}
+
+// reads the events of the log stream after the cursor and advances it
+func (c *logStreamCursor) read(cwl *CWL.CloudWatchLogs, filter string) ([]CWL.LogLine, error) {
+	startTime := c.StartTime
+	if c.LastTimestamp != 0 {
+		startTime = time.Unix(0, c.LastTimestamp*int64(time.Millisecond))
+	}
+
+	// filter patterns are only supported by FilterLogEvents, which has no
+	// forward token, so the events already read at the last timestamp are skipped
+	if filter != "" {
+		logLines, err := cwl.FilterLogEvents(
+			&CWL.GetLogsInput{
+				LogGroupName:   c.LogGroupName,
+				LogStreamNames: []string{c.LogStreamName},
+				Filter:         filter,
+				StartTime:      startTime,
+			},
+		)
+
+		if err != nil {
+			return nil, err
+		}
+
+		skip := c.EventsAtLastTimestamp
+		logLines = skipSeenEvents(logLines, c.LastTimestamp, &skip)
+		c.advance(logLines)
+
+		return logLines, nil
+	}
+
+	var logLines []CWL.LogLine
+
+	input := &CWL.GetLogEventsInput{
+		LogGroupName:  c.LogGroupName,
+		LogStreamName: c.LogStreamName,
+		StartTime:     startTime,
+		NextToken:     c.NextToken,
+	}
+
+	skip := 0
+	if c.NextToken == "" {
+		skip = c.EventsAtLastTimestamp
+	}
+
+	for {
+		output, err := cwl.GetLogEventsPage(input)
+		if err != nil {
+			return logLines, err
+		}
+
+		lines := skipSeenEvents(output.LogLines, c.LastTimestamp, &skip)
+		c.advance(lines)
+		logLines = append(logLines, lines...)
+
+		if output.NextToken == "" {
+			break
+		}
+
+		c.NextToken = output.NextToken
+		input.NextToken = output.NextToken
+	}
+
+	return logLines, nil
+}
+
+// records read events
+func (c *logStreamCursor) advance(logLines []CWL.LogLine) {
+	for _, logLine := range logLines {
+		timestamp := toMilliseconds(logLine.Timestamp)
+
+		if timestamp == c.LastTimestamp {
+			c.EventsAtLastTimestamp++
+		} else {
+			c.LastTimestamp = timestamp
+			c.EventsAtLastTimestamp = 1
+		}
+	}
+}
+
+// schedules the next poll of the cursor; idle streams are polled less often
+func (c *logStreamCursor) schedule(now time.Time, events int) {
+	c.interval = nextPollInterval(c.interval, events)
+	c.nextPoll = now.Add(c.interval)
+}
+
+// backs off polling the cursor after it was throttled
+func (c *logStreamCursor) backoff(now time.Time) {
+	c.interval = nextBackoff(c.interval)
+	c.nextPoll = now.Add(c.interval)
+}
+
+// returns the poll interval of a stream: the minimum interval after new events,
+// doubling up to the maximum interval while the stream is idle
+func nextPollInterval(interval time.Duration, events int) time.Duration {
+	if events > 0 || interval < followPollInterval {
+		return followPollInterval
+	}
+
+	if interval *= 2; interval > followMaxPollInterval {
+		return followMaxPollInterval
+	}
+
+	return interval
+}
+
+// returns an exponential backoff of an interval after throttling
+func nextBackoff(interval time.Duration) time.Duration {
+	if interval < followPollInterval {
+		interval = followPollInterval
+	}
+
+	if interval *= 2; interval > followMaxBackoff {
+		return followMaxBackoff
+	}
+
+	return interval
+}
+
+// logsCheckpoint records the position of follow mode in each log stream so that
+// --since-last can resume following after a disconnect
+type logsCheckpoint struct {
+	SavedAt time.Time                       `json:"savedAt"`
+	Streams map[string]logsCheckpointStream `json:"streams"`
+
+	path string
+}
+
+type logsCheckpointStream struct {
+	LastTimestamp         int64 `json:"lastTimestamp"`
+	EventsAtLastTimestamp int   `json:"eventsAtLastTimestamp"`
+}
+
+// returns the path of the checkpoint of the sources and filter of an operation
+// in the user cache directory
+func logsCheckpointPath(operation *GetLogsOperation) (string, error) {
+	cacheDir, err := os.UserCacheDir()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(cacheDir, logsCheckpointDirectory, logsCheckpointKey(operation.Sources, operation.Filter)+".json"), nil
+}
+
+// identifies the log streams being followed, independently of the order of
+// the sources
+func logsCheckpointKey(sources []LogSource, filter string) string {
+	var parts []string
+
+	for _, source := range sources {
+		streams := append([]string{}, source.LogStreamNames...)
+		sort.Strings(streams)
+
+		parts = append(parts, strings.Join(append([]string{source.LogGroupName, source.LogStreamPrefix}, streams...), "\n"))
+	}
+
+	sort.Strings(parts)
+
+	hash := sha1.Sum([]byte(strings.Join(append(parts, filter), "\n\n")))
+
+	return hex.EncodeToString(hash[:])
+}
+
+// reads a checkpoint, returning nil if there is none
+func loadLogsCheckpoint(path string) (*logsCheckpoint, error) {
+	bits, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	checkpoint := &logsCheckpoint{}
+	if err := json.Unmarshal(bits, checkpoint); err != nil {
+		return nil, err
+	}
+
+	checkpoint.path = path
+
+	return checkpoint, nil
+}
+
+func (c *logsCheckpoint) save() error {
+	c.SavedAt = time.Now()
+
+	bits, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(c.path), 0755); err != nil {
+		return err
+	}
+
+	// write and rename so an interrupted write does not corrupt the checkpoint
+	tmp := c.path + ".tmp"
+	if err := ioutil.WriteFile(tmp, bits, 0644); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp, c.path)
+}
+
+// logFollower polls the log streams of the sources of an operation
+type logFollower struct {
+	operation  *GetLogsOperation
+	cwl        CWL.CloudWatchLogs
+	cursors    map[string]*logStreamCursor
+	checkpoint *logsCheckpoint
+
+	refreshInterval time.Duration
+	nextRefresh     time.Time
+
+	//whether the recently stopped tasks of services were listed
+	listedStoppedTasks bool
+}
+
+func newLogFollower(operation *GetLogsOperation) *logFollower {
+	follower := &logFollower{
+		operation:       operation,
+		cwl:             operation.cwl,
+		cursors:         make(map[string]*logStreamCursor),
+		refreshInterval: logStreamRefreshInterval,
+	}
+
+	if operation.SaveCheckpoint || operation.SinceLast {
+		follower.loadCheckpoint()
+	}
+
+	if operation.StartTime.IsZero() {
+		operation.StartTime = time.Now()
+	}
+
+	return follower
+}
+
+func (f *logFollower) loadCheckpoint() {
+	path, err := logsCheckpointPath(f.operation)
+	if err != nil {
+		console.ErrorExit(err, "Could not locate the logs checkpoint")
+	}
+
+	f.checkpoint = &logsCheckpoint{
+		Streams: make(map[string]logsCheckpointStream),
+		path:    path,
+	}
+
+	if !f.operation.SinceLast {
+		return
+	}
+
+	checkpoint, err := loadLogsCheckpoint(path)
+	if err != nil {
+		console.ErrorExit(err, "Could not read the logs checkpoint %s", path)
+	}
+
+	if checkpoint == nil {
+		console.Info("No checkpoint found, following logs from now")
+		return
+	}
+
+	console.Info("Resuming logs from %s", checkpoint.SavedAt.Format(timeFormatWithZone))
+
+	// streams that were not followed before start from the last checkpoint
+	f.checkpoint = checkpoint
+	f.operation.StartTime = checkpoint.SavedAt
+}
+
+// follows the log streams of the sources until StopFollowing returns true, or forever
+func (f *logFollower) follow() {
+	ticker := time.NewTicker(followPollInterval)
+
+	for {
+		f.poll(false)
+
+		//fetch events ingested after the stop condition was met one last time
+		if f.operation.StopFollowing != nil && f.operation.StopFollowing() {
+			<-ticker.C
+			f.poll(true)
+			return
+		}
+
+		<-ticker.C
+	}
+}
+
+// reads the streams that are due (or all of them) and prints their new events
+// in time order
+func (f *logFollower) poll(all bool) {
+	now := time.Now()
+
+	if all || !now.Before(f.nextRefresh) {
+		f.refresh(now)
+	}
+
+	var due []*logStreamCursor
+
+	for _, cursor := range f.cursors {
+		if all || !now.Before(cursor.nextPoll) {
+			due = append(due, cursor)
+		}
+	}
+
+	var (
+		logLines []sourceLogLine
+		mu       sync.Mutex
+		wg       sync.WaitGroup
+	)
+
+	cursors := make(chan *logStreamCursor)
+
+	for i := 0; i < followConcurrency; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for cursor := range cursors {
+				lines := f.read(cursor, now)
+
+				mu.Lock()
+				for _, line := range lines {
+					logLines = append(logLines, sourceLogLine{LogLine: line, Source: cursor.Source})
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+
+	for _, cursor := range due {
+		cursors <- cursor
+	}
+
+	close(cursors)
+	wg.Wait()
+
+	for _, logLine := range mergeLogLines(logLines) {
+		var logTime string
+		if f.operation.IncludeTime {
+			logTime = logLine.Timestamp.Format(time.RFC3339)
+		}
+
+		streamColor := f.operation.GetStreamColor(logLine.Source + logLine.LogStreamName)
+		f.operation.printLogLine(logLine.Source, logLine.LogLine, streamColor, logTime)
+	}
+
+	if len(logLines) > 0 {
+		f.saveCheckpoint()
+	}
+}
+
+// reads the new events of a stream, handling throttling and streams that do
+// not exist yet
+func (f *logFollower) read(cursor *logStreamCursor, now time.Time) []CWL.LogLine {
+	logLines, err := cursor.read(&f.cwl, f.operation.Filter)
+
+	switch {
+	case err == nil:
+		cursor.schedule(now, len(logLines))
+	case awserrors.IsThrottled(err):
+		cursor.backoff(now)
+		console.Debug("Throttled reading %s, retrying in %s", cursor.LogStreamName, cursor.interval)
+	case awserrors.IsNotFound(err):
+		cursor.schedule(now, 0)
+	default:
+		console.ErrorExit(err, "Could not get logs for: %s", cursor.LogStreamName)
+	}
+
+	return logLines
+}
+
+// discovers the streams of the sources, including those of newly started
+// tasks. The tasks of services are listed in ECS, as the log groups of long
+// lived services can have many thousands of streams; the streams of other
+// sources are listed by prefix.
+func (f *logFollower) refresh(now time.Time) {
+	activeSince := f.operation.StartTime.Add(-logStreamActivityWindow)
+	serviceTaskIds := make(map[string][]string)
+
+	for _, source := range f.operation.Sources {
+		logStreamNames := source.LogStreamNames
+
+		if len(logStreamNames) == 0 && source.ServiceName != "" {
+			taskIds, ok := serviceTaskIds[source.ServiceName]
+
+			if !ok {
+				var err error
+
+				taskIds, err = f.listServiceTaskIds(source.ServiceName)
+
+				switch {
+				case err == nil:
+				case awserrors.IsThrottled(err):
+					f.throttled(now, "Throttled listing tasks")
+					return
+				default:
+					console.ErrorExit(err, "Could not list tasks of service %s", source.ServiceName)
+				}
+
+				serviceTaskIds[source.ServiceName] = taskIds
+			}
+
+			for _, taskId := range taskIds {
+				logStreamNames = append(logStreamNames, source.LogStreamPrefix+taskId)
+			}
+		} else if len(logStreamNames) == 0 {
+			logStreams, err := f.cwl.DescribeLogStreams(source.LogGroupName, source.LogStreamPrefix)
+
+			switch {
+			case err == nil:
+			case awserrors.IsThrottled(err):
+				f.throttled(now, "Throttled listing log streams")
+				return
+			case awserrors.IsNotFound(err):
+				continue
+			default:
+				console.ErrorExit(err, "Could not list log streams for: %s", source.LogGroupName)
+			}
+
+			for _, logStream := range logStreams {
+				if logStream.LastEventTime.IsZero() || logStream.LastEventTime.After(activeSince) {
+					logStreamNames = append(logStreamNames, logStream.Name)
+				}
+			}
+		}
+
+		for _, logStreamName := range logStreamNames {
+			f.addCursor(source, logStreamName)
+		}
+	}
+
+	f.listedStoppedTasks = true
+	f.refreshInterval = logStreamRefreshInterval
+	f.nextRefresh = now.Add(f.refreshInterval)
+}
+
+// returns the ids of the running tasks of a service; the first refresh also
+// returns the tasks that stopped recently, which may have logged since the
+// start time
+func (f *logFollower) listServiceTaskIds(serviceName string) ([]string, error) {
+	ecs := ecsOrDefault(f.operation.ecs)
+	f.operation.ecs = ecs
+
+	taskIds, err := ecs.ListTaskIdsForService(serviceName, awsecs.DesiredStatusRunning)
+	if err != nil || f.listedStoppedTasks {
+		return taskIds, err
+	}
+
+	stoppedTaskIds, err := ecs.ListTaskIdsForService(serviceName, awsecs.DesiredStatusStopped)
+
+	return append(taskIds, stoppedTaskIds...), err
+}
+
+// backs off refreshing the streams after being throttled
+func (f *logFollower) throttled(now time.Time, message string) {
+	f.refreshInterval = nextBackoff(f.refreshInterval)
+	f.nextRefresh = now.Add(f.refreshInterval)
+	console.Debug("%s, retrying in %s", message, f.refreshInterval)
+}
+
+func (f *logFollower) addCursor(source LogSource, logStreamName string) {
+	key := source.LogGroupName + ":" + logStreamName
+
+	if _, ok := f.cursors[key]; ok {
+		return
+	}
+
+	cursor := &logStreamCursor{
+		Source:        source.Name,
+		LogGroupName:  source.LogGroupName,
+		LogStreamName: logStreamName,
+		StartTime:     f.operation.StartTime,
+	}
+
+	if f.checkpoint != nil {
+		if stream, ok := f.checkpoint.Streams[key]; ok {
+			cursor.LastTimestamp = stream.LastTimestamp
+			cursor.EventsAtLastTimestamp = stream.EventsAtLastTimestamp
+		}
+	}
+
+	f.cursors[key] = cursor
+}
+
+func (f *logFollower) saveCheckpoint() {
+	if f.checkpoint == nil {
+		return
+	}
+
+	for key, cursor := range f.cursors {
+		if cursor.LastTimestamp != 0 {
+			f.checkpoint.Streams[key] = logsCheckpointStream{
+				LastTimestamp:         cursor.LastTimestamp,
+				EventsAtLastTimestamp: cursor.EventsAtLastTimestamp,
+			}
+		}
+	}
+
+	if err := f.checkpoint.save(); err != nil {
+		console.Debug("Could not save the logs checkpoint: %v", err)
+	}
+}
@@ -0,0 +1,156 @@
+package cmd
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awsecs "github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/golang/mock/gomock"
+	CWL "github.com/turnerlabs/fargate/cloudwatchlogs"
+	cwlsdk "github.com/turnerlabs/fargate/cloudwatchlogs/mock/sdk"
+	ECS "github.com/turnerlabs/fargate/ecs"
+	ecssdk "github.com/turnerlabs/fargate/ecs/mock/sdk"
+)
+
+func TestNextPollInterval(t *testing.T) {
+	tests := []struct {
+		interval time.Duration
+		events   int
+		expected time.Duration
+	}{
+		{0, 0, followPollInterval},
+		{followPollInterval, 0, 2 * time.Second},
+		{4 * time.Second, 0, 8 * time.Second},
+		{8 * time.Second, 0, followMaxPollInterval},
+		{followMaxPollInterval, 3, followPollInterval},
+	}
+
+	for _, test := range tests {
+		if got := nextPollInterval(test.interval, test.events); got != test.expected {
+			t.Errorf("Expected %s for %s with %d events, got %s", test.expected, test.interval, test.events, got)
+		}
+	}
+}
+
+func TestNextBackoff(t *testing.T) {
+	if got := nextBackoff(0); got != 2*time.Second {
+		t.Errorf("Expected 2s, got %s", got)
+	}
+
+	if got := nextBackoff(40 * time.Second); got != followMaxBackoff {
+		t.Errorf("Expected %s, got %s", followMaxBackoff, got)
+	}
+}
+
+func TestLogStreamCursorAdvance(t *testing.T) {
+	cursor := &logStreamCursor{}
+	cursor.advance(logLinesAt(1000, 2000, 2000))
+
+	if cursor.LastTimestamp != 2000 || cursor.EventsAtLastTimestamp != 2 {
+		t.Errorf("Expected 2 events at 2000, got %d at %d", cursor.EventsAtLastTimestamp, cursor.LastTimestamp)
+	}
+
+	cursor.advance(logLinesAt(2000))
+
+	if cursor.EventsAtLastTimestamp != 3 {
+		t.Errorf("Expected 3 events at 2000, got %d", cursor.EventsAtLastTimestamp)
+	}
+}
+
+func TestLogsCheckpointKey(t *testing.T) {
+	api := LogSource{Name: "api", LogGroupName: "/fargate/service/api", LogStreamPrefix: "fargate/api/"}
+	worker := LogSource{Name: "worker", LogGroupName: "/fargate/service/worker", LogStreamPrefix: "fargate/worker/"}
+
+	if logsCheckpointKey([]LogSource{api, worker}, "") != logsCheckpointKey([]LogSource{worker, api}, "") {
+		t.Error("Expected the key to be independent of the order of the sources")
+	}
+
+	if logsCheckpointKey([]LogSource{api}, "") == logsCheckpointKey([]LogSource{api}, "error") {
+		t.Error("Expected the key to depend on the filter")
+	}
+}
+
+func TestLogsCheckpointSave(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "logs", "checkpoint.json")
+
+	if checkpoint, err := loadLogsCheckpoint(path); err != nil || checkpoint != nil {
+		t.Fatalf("Expected no checkpoint, got %v (%v)", checkpoint, err)
+	}
+
+	checkpoint := &logsCheckpoint{
+		Streams: map[string]logsCheckpointStream{
+			"/fargate/service/api:fargate/api/abc": logsCheckpointStream{LastTimestamp: 2000, EventsAtLastTimestamp: 2},
+		},
+		path: path,
+	}
+
+	if err := checkpoint.save(); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	loaded, err := loadLogsCheckpoint(path)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	stream := loaded.Streams["/fargate/service/api:fargate/api/abc"]
+	if stream.LastTimestamp != 2000 || stream.EventsAtLastTimestamp != 2 {
+		t.Errorf("Expected 2 events at 2000, got %d at %d", stream.EventsAtLastTimestamp, stream.LastTimestamp)
+	}
+
+	if loaded.SavedAt.IsZero() {
+		t.Error("Expected the time of the checkpoint to be saved")
+	}
+}
+
+func TestLogFollowerRefreshServiceTasks(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	listTasks := func(taskArns ...string) func(*awsecs.ListTasksInput, func(*awsecs.ListTasksOutput, bool) bool) error {
+		return func(input *awsecs.ListTasksInput, fn func(*awsecs.ListTasksOutput, bool) bool) error {
+			if aws.StringValue(input.ServiceName) != "web" {
+				t.Errorf("Expected the tasks of web to be listed, got %s", aws.StringValue(input.ServiceName))
+			}
+
+			fn(&awsecs.ListTasksOutput{TaskArns: aws.StringSlice(taskArns)}, true)
+			return nil
+		}
+	}
+
+	//the log streams are not listed
+	mockCWLClient := cwlsdk.NewMockCloudWatchLogsAPI(mockCtrl)
+	mockECSClient := ecssdk.NewMockECSAPI(mockCtrl)
+
+	gomock.InOrder(
+		mockECSClient.EXPECT().ListTasksPages(gomock.Any(), gomock.Any()).DoAndReturn(listTasks("arn:aws:ecs:us-east-1:123456789012:task/my-cluster/abc")),
+		mockECSClient.EXPECT().ListTasksPages(gomock.Any(), gomock.Any()).DoAndReturn(listTasks("arn:aws:ecs:us-east-1:123456789012:task/my-cluster/old")),
+		mockECSClient.EXPECT().ListTasksPages(gomock.Any(), gomock.Any()).DoAndReturn(listTasks("arn:aws:ecs:us-east-1:123456789012:task/my-cluster/abc", "arn:aws:ecs:us-east-1:123456789012:task/my-cluster/def")),
+	)
+
+	ecs := ECS.NewWithClient(mockECSClient, "my-cluster")
+	operation := &GetLogsOperation{
+		Follow: true,
+		Sources: []LogSource{
+			LogSource{Name: "web", LogGroupName: "/fargate/service/web", LogStreamPrefix: "fargate/web/", ServiceName: "web"},
+		},
+		ecs: &ecs,
+		cwl: CWL.NewWithClient(mockCWLClient),
+	}
+
+	follower := newLogFollower(operation)
+	follower.refresh(time.Now())
+	follower.refresh(time.Now())
+
+	for _, logStreamName := range []string{"fargate/web/abc", "fargate/web/old", "fargate/web/def"} {
+		if _, ok := follower.cursors["/fargate/service/web:"+logStreamName]; !ok {
+			t.Errorf("Expected a cursor for %s", logStreamName)
+		}
+	}
+
+	if len(follower.cursors) != 3 {
+		t.Errorf("Expected 3 cursors, got %d", len(follower.cursors))
+	}
+}
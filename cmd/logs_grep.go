@@ -0,0 +1,128 @@
+package cmd
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/mgutz/ansi"
+	CWL "github.com/turnerlabs/fargate/cloudwatchlogs"
+	"github.com/turnerlabs/fargate/console"
+)
+
+var grepHighlight = ansi.ColorCode("black:yellow")
+
+// logGrep selects log lines matching a regular expression client-side, along
+// with lines of context from the same log stream
+type logGrep struct {
+	Pattern *regexp.Regexp
+	Before  int
+	After   int
+	Invert  bool
+
+	streams map[string]*logGrepStream
+}
+
+// the context of a log stream: the lines that may precede the next match and
+// the number of lines still to print after the last match
+type logGrepStream struct {
+	before []grepLogLine
+	after  int
+}
+
+// a log line waiting to be printed
+type grepLogLine struct {
+	Source      string
+	LogLine     CWL.LogLine
+	StreamColor int
+	LogTime     string
+	Match       bool
+
+	// the fields of a JSON message, nil if the message is not a JSON object
+	Fields map[string]interface{}
+}
+
+func (o *GetLogsOperation) AddGrep(pattern string, before, after int, invert bool) {
+	if pattern == "" {
+		if invert || before > 0 || after > 0 {
+			console.ErrorExit(fmt.Errorf("--invert, --before-context and --after-context require --grep"), "Invalid command line flags")
+		}
+
+		return
+	}
+
+	if before < 0 || after < 0 {
+		console.ErrorExit(fmt.Errorf("--before-context and --after-context cannot be negative"), "Invalid command line flags")
+	}
+
+	regex, err := regexp.Compile(pattern)
+	if err != nil {
+		console.ErrorExit(err, "Invalid --grep expression")
+	}
+
+	o.Grep = &logGrep{
+		Pattern: regex,
+		Before:  before,
+		After:   after,
+		Invert:  invert,
+	}
+}
+
+func (g *logGrep) matches(message string) bool {
+	return g.Pattern.MatchString(message) != g.Invert
+}
+
+// returns the lines to print for a log line: nothing, the line itself as
+// context, or a match preceded by its context
+func (g *logGrep) filter(line grepLogLine) []grepLogLine {
+	if g.streams == nil {
+		g.streams = make(map[string]*logGrepStream)
+	}
+
+	key := line.Source + line.LogLine.LogStreamName
+
+	stream, ok := g.streams[key]
+	if !ok {
+		stream = &logGrepStream{}
+		g.streams[key] = stream
+	}
+
+	if g.matches(line.LogLine.Message) {
+		line.Match = true
+		lines := append(stream.before, line)
+
+		stream.before = nil
+		stream.after = g.After
+
+		return lines
+	}
+
+	if stream.after > 0 {
+		stream.after--
+		return []grepLogLine{line}
+	}
+
+	if g.Before > 0 {
+		stream.before = append(stream.before, line)
+
+		if len(stream.before) > g.Before {
+			stream.before = stream.before[len(stream.before)-g.Before:]
+		}
+	}
+
+	return nil
+}
+
+// highlights the matches of the pattern in a message
+func (g *logGrep) highlight(message string) string {
+	if !console.Color || g.Invert {
+		return message
+	}
+
+	return g.Pattern.ReplaceAllStringFunc(message, func(match string) string {
+		if match == "" {
+			return match
+		}
+
+		return grepHighlight + match + reset
+	})
+}
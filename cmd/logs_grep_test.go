@@ -0,0 +1,68 @@
+package cmd
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+
+	CWL "github.com/turnerlabs/fargate/cloudwatchlogs"
+	"github.com/turnerlabs/fargate/console"
+)
+
+func grepLines(grep *logGrep, stream string, messages ...string) string {
+	var printed []string
+
+	for _, message := range messages {
+		line := grepLogLine{LogLine: CWL.LogLine{LogStreamName: stream, Message: message}}
+
+		for _, l := range grep.filter(line) {
+			printed = append(printed, l.LogLine.Message)
+		}
+	}
+
+	return strings.Join(printed, ",")
+}
+
+func TestLogGrepContext(t *testing.T) {
+	grep := &logGrep{Pattern: regexp.MustCompile("^err"), Before: 1, After: 1}
+
+	got := grepLines(grep, "fargate/app/abc", "a", "b", "error", "c", "d", "e", "err2", "f")
+	if got != "b,error,c,e,err2,f" {
+		t.Errorf("Expected b,error,c,e,err2,f, got %s", got)
+	}
+}
+
+func TestLogGrepContextPerStream(t *testing.T) {
+	grep := &logGrep{Pattern: regexp.MustCompile("error"), Before: 1}
+
+	grepLines(grep, "fargate/app/abc", "a")
+	grepLines(grep, "fargate/app/def", "b")
+
+	if got := grepLines(grep, "fargate/app/abc", "error"); got != "a,error" {
+		t.Errorf("Expected a,error, got %s", got)
+	}
+}
+
+func TestLogGrepInvert(t *testing.T) {
+	grep := &logGrep{Pattern: regexp.MustCompile("health"), Invert: true}
+
+	if got := grepLines(grep, "fargate/app/abc", "GET /health", "GET /users", "GET /health"); got != "GET /users" {
+		t.Errorf("Expected GET /users, got %s", got)
+	}
+}
+
+func TestLogGrepHighlight(t *testing.T) {
+	grep := &logGrep{Pattern: regexp.MustCompile("o+")}
+
+	console.Color = false
+	if got := grep.highlight("foo"); got != "foo" {
+		t.Errorf("Expected foo, got %s", got)
+	}
+
+	console.Color = true
+	defer func() { console.Color = false }()
+
+	if got := grep.highlight("foo"); got != "f"+grepHighlight+"oo"+reset {
+		t.Errorf("Expected highlighted oo, got %q", got)
+	}
+}
@@ -0,0 +1,322 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	CWL "github.com/turnerlabs/fargate/cloudwatchlogs"
+	"github.com/turnerlabs/fargate/console"
+)
+
+const (
+	logOutputText   = "text"
+	logOutputNDJSON = "ndjson"
+)
+
+// logLevel is the severity of a structured log message, from least to most severe
+type logLevel int
+
+const (
+	levelTrace logLevel = iota
+	levelDebug
+	levelInfo
+	levelWarn
+	levelError
+	levelFatal
+)
+
+// fields that commonly hold the severity of a structured log message
+var logLevelFields = []string{"level", "severity", "lvl", "loglevel", "log.level"}
+
+var logLevels = map[string]logLevel{
+	"trace":    levelTrace,
+	"debug":    levelDebug,
+	"info":     levelInfo,
+	"notice":   levelInfo,
+	"warn":     levelWarn,
+	"warning":  levelWarn,
+	"err":      levelError,
+	"error":    levelError,
+	"crit":     levelFatal,
+	"critical": levelFatal,
+	"fatal":    levelFatal,
+	"panic":    levelFatal,
+}
+
+// logLevelFilter matches a severity, or a severity and everything above it
+// (e.g. error+)
+type logLevelFilter struct {
+	Level    logLevel
+	AndAbove bool
+}
+
+func parseLogLevelFilter(expression string) (*logLevelFilter, error) {
+	filter := &logLevelFilter{}
+	name := strings.ToLower(strings.TrimSpace(expression))
+
+	if strings.HasSuffix(name, "+") {
+		filter.AndAbove = true
+		name = strings.TrimSuffix(name, "+")
+	}
+
+	level, ok := logLevels[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown log level %s (use trace, debug, info, warn, error or fatal, optionally followed by +)", expression)
+	}
+
+	filter.Level = level
+
+	return filter, nil
+}
+
+func (f *logLevelFilter) matches(level logLevel) bool {
+	if f.AndAbove {
+		return level >= f.Level
+	}
+
+	return level == f.Level
+}
+
+// logEvent is a log event as rendered by --output ndjson
+type logEvent struct {
+	Timestamp string      `json:"timestamp"`
+	Source    string      `json:"source,omitempty"`
+	Stream    string      `json:"stream"`
+	TaskId    string      `json:"taskId"`
+	Message   interface{} `json:"message"`
+}
+
+// parses a log message as a JSON object
+func parseJSONMessage(message string) (map[string]interface{}, bool) {
+	var fields map[string]interface{}
+
+	trimmed := strings.TrimSpace(message)
+	if !strings.HasPrefix(trimmed, "{") {
+		return nil, false
+	}
+
+	if err := json.Unmarshal([]byte(trimmed), &fields); err != nil {
+		return nil, false
+	}
+
+	return fields, true
+}
+
+// looks up a field by name or by a dot separated path to a nested field
+func lookupJSONField(fields map[string]interface{}, path string) (interface{}, bool) {
+	if value, ok := fields[path]; ok {
+		return value, true
+	}
+
+	parts := strings.SplitN(path, ".", 2)
+	if len(parts) != 2 {
+		return nil, false
+	}
+
+	if nested, ok := fields[parts[0]].(map[string]interface{}); ok {
+		return lookupJSONField(nested, parts[1])
+	}
+
+	return nil, false
+}
+
+// returns the name of the field holding the severity of a structured log
+// message, and the severity
+func messageLevel(fields map[string]interface{}) (string, logLevel, bool) {
+	for _, name := range logLevelFields {
+		value, ok := lookupJSONField(fields, name)
+		if !ok {
+			continue
+		}
+
+		switch v := value.(type) {
+		case string:
+			if level, ok := logLevels[strings.ToLower(v)]; ok {
+				return name, level, true
+			}
+		case float64:
+			// numeric levels as used by bunyan and pino
+			switch {
+			case v <= 10:
+				return name, levelTrace, true
+			case v <= 20:
+				return name, levelDebug, true
+			case v <= 30:
+				return name, levelInfo, true
+			case v <= 40:
+				return name, levelWarn, true
+			case v <= 50:
+				return name, levelError, true
+			default:
+				return name, levelFatal, true
+			}
+		}
+	}
+
+	return "", 0, false
+}
+
+func formatJSONValue(value interface{}) string {
+	if s, ok := value.(string); ok {
+		return s
+	}
+
+	bits, _ := json.Marshal(value)
+
+	return string(bits)
+}
+
+func colorLevel(value string, level logLevel) string {
+	if !console.Color {
+		return value
+	}
+
+	switch level {
+	case levelFatal, levelError:
+		return red + value + reset
+	case levelWarn:
+		return yellow + value + reset
+	case levelInfo:
+		return green + value + reset
+	default:
+		return blue + value + reset
+	}
+}
+
+// renders the selected fields of a structured log message as key=value pairs
+func formatJSONFields(fields map[string]interface{}, names []string) string {
+	var parts []string
+
+	levelField, level, hasLevel := messageLevel(fields)
+
+	for _, name := range names {
+		value, ok := lookupJSONField(fields, name)
+		if !ok {
+			continue
+		}
+
+		formatted := formatJSONValue(value)
+		if strings.ContainsAny(formatted, " \"=") {
+			formatted = strconv.Quote(formatted)
+		}
+
+		if hasLevel && name == levelField {
+			formatted = colorLevel(formatted, level)
+		}
+
+		parts = append(parts, name+"="+formatted)
+	}
+
+	return strings.Join(parts, " ")
+}
+
+// returns the task id of a log stream named <prefix>/<container>/<task-id>
+func logStreamTaskId(logStreamName string) string {
+	return logStreamName[strings.LastIndex(logStreamName, "/")+1:]
+}
+
+// prints a log line according to the output, field and level options of the operation
+func (o *GetLogsOperation) printLogLine(source string, logLine CWL.LogLine, streamColor int, logTime string) {
+	fields, _ := parseJSONMessage(logLine.Message)
+
+	if o.LevelFilter != nil {
+		if fields == nil {
+			return
+		}
+
+		if _, level, ok := messageLevel(fields); !ok || !o.LevelFilter.matches(level) {
+			return
+		}
+	}
+
+	line := grepLogLine{
+		Source:      source,
+		LogLine:     logLine,
+		StreamColor: streamColor,
+		LogTime:     logTime,
+		Fields:      fields,
+	}
+
+	if o.Grep != nil {
+		for _, l := range o.Grep.filter(line) {
+			o.writeLogLine(l)
+		}
+
+		return
+	}
+
+	o.writeLogLine(line)
+}
+
+// writes a log line in the output format of the operation, highlighting grep
+// matches if requested
+func (o *GetLogsOperation) writeLogLine(line grepLogLine) {
+	source, logLine, fields := line.Source, line.LogLine, line.Fields
+
+	if o.Output == logOutputNDJSON {
+		event := logEvent{
+			Timestamp: logLine.Timestamp.UTC().Format(time.RFC3339Nano),
+			Source:    source,
+			Stream:    logLine.LogStreamName,
+			TaskId:    logStreamTaskId(logLine.LogStreamName),
+			Message:   logLine.Message,
+		}
+
+		if fields != nil {
+			event.Message = selectJSONFields(fields, o.JSONFields)
+		}
+
+		bits, err := json.Marshal(event)
+		if err != nil {
+			console.ErrorExit(err, "Could not marshal log event")
+		}
+
+		fmt.Println(string(bits))
+		return
+	}
+
+	message := logLine.Message
+	if fields != nil && len(o.JSONFields) > 0 {
+		message = formatJSONFields(fields, o.JSONFields)
+	}
+
+	if line.Match {
+		message = o.Grep.highlight(message)
+	}
+
+	prefix, noPrefix := logLine.LogStreamName, o.NoLogStreamPrefix
+
+	//always label merged logs with their source
+	if source != "" {
+		if noPrefix {
+			prefix = source
+		} else {
+			prefix = source + " " + prefix
+		}
+
+		noPrefix = false
+	}
+
+	console.LogLine(prefix, message, line.StreamColor, line.LogTime, noPrefix)
+}
+
+// returns only the selected fields of a structured log message (or all of them
+// if none are selected)
+func selectJSONFields(fields map[string]interface{}, names []string) map[string]interface{} {
+	if len(names) == 0 {
+		return fields
+	}
+
+	selected := make(map[string]interface{})
+
+	for _, name := range names {
+		if value, ok := lookupJSONField(fields, name); ok {
+			selected[name] = value
+		}
+	}
+
+	return selected
+}
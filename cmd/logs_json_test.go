@@ -0,0 +1,85 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/turnerlabs/fargate/console"
+)
+
+func TestParseLogLevelFilter(t *testing.T) {
+	filter, err := parseLogLevelFilter("error+")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !filter.matches(levelError) || !filter.matches(levelFatal) || filter.matches(levelWarn) {
+		t.Errorf("Expected error+ to match error and fatal only")
+	}
+
+	filter, err = parseLogLevelFilter("WARNING")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !filter.matches(levelWarn) || filter.matches(levelError) {
+		t.Errorf("Expected warning to match warn only")
+	}
+
+	if _, err := parseLogLevelFilter("loud"); err == nil {
+		t.Error("Expected error for an unknown level")
+	}
+}
+
+func TestMessageLevel(t *testing.T) {
+	tests := []struct {
+		message string
+		field   string
+		level   logLevel
+		found   bool
+	}{
+		{`{"level":"ERROR","msg":"boom"}`, "level", levelError, true},
+		{`{"severity":"warning"}`, "severity", levelWarn, true},
+		{`{"level":30,"msg":"pino"}`, "level", levelInfo, true},
+		{`{"log":{"level":"debug"}}`, "log.level", levelDebug, true},
+		{`{"msg":"no level"}`, "", 0, false},
+	}
+
+	for _, test := range tests {
+		fields, ok := parseJSONMessage(test.message)
+		if !ok {
+			t.Fatalf("Expected %s to be parsed", test.message)
+		}
+
+		field, level, found := messageLevel(fields)
+		if field != test.field || level != test.level || found != test.found {
+			t.Errorf("%s: expected (%s, %d, %t), got (%s, %d, %t)", test.message, test.field, test.level, test.found, field, level, found)
+		}
+	}
+}
+
+func TestParseJSONMessage_NotJSON(t *testing.T) {
+	for _, message := range []string{"plain text", "[1,2]", "{broken"} {
+		if _, ok := parseJSONMessage(message); ok {
+			t.Errorf("Expected %s not to be parsed", message)
+		}
+	}
+}
+
+func TestFormatJSONFields(t *testing.T) {
+	color := console.Color
+	console.Color = false
+	defer func() { console.Color = color }()
+
+	fields, _ := parseJSONMessage(`{"level":"info","msg":"request done","trace_id":"abc","http":{"status":200}}`)
+
+	expected := `level=info msg="request done" http.status=200`
+	if got := formatJSONFields(fields, []string{"level", "msg", "missing", "http.status"}); got != expected {
+		t.Errorf("Expected %s, got %s", expected, got)
+	}
+}
+
+func TestLogStreamTaskId(t *testing.T) {
+	if got := logStreamTaskId("fargate/app/0123456789abcdef"); got != "0123456789abcdef" {
+		t.Errorf("Expected 0123456789abcdef, got %s", got)
+	}
+}
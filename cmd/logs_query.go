@@ -0,0 +1,223 @@
+package cmd
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	CWL "github.com/turnerlabs/fargate/cloudwatchlogs"
+	"github.com/turnerlabs/fargate/console"
+)
+
+const (
+	queryFormatTable = "table"
+	queryFormatJSON  = "json"
+	queryFormatCSV   = "csv"
+
+	defaultQueryStartTime = "-1h"
+	defaultQueryLimit     = 1000
+	defaultInsightsQuery  = "fields @timestamp, @logStream, @message | sort @timestamp desc"
+)
+
+// the flags of a logs query command
+type logsQueryFlags struct {
+	startTime string
+	endTime   string
+	stats     string
+	format    string
+	limit     int64
+}
+
+// newLogsQueryCmd returns a query command for the logs of a service or task,
+// described as "the log group of the <source>" and queried in the log group
+// returned by logGroupFor
+func newLogsQueryCmd(source, logGroup string, logGroupFor func() string) *cobra.Command {
+	flags := &logsQueryFlags{}
+
+	cmd := &cobra.Command{
+		Use:   "query [<insights-query>]",
+		Short: fmt.Sprintf("Run a CloudWatch Logs Insights query on %s logs", source),
+		Long: fmt.Sprintf(`Run a CloudWatch Logs Insights query on %[1]s logs
+
+Runs a CloudWatch Logs Insights query on %[2]s,
+waits for it to complete and prints the results as a table, JSON or CSV
+(--format).
+
+Without a query, the most recent log events are returned. --stats appends a
+stats command to the query, e.g. --stats "count(*) by bin(5m)".
+
+The time range defaults to the last hour and can be changed with --start and
+--end, which accept the same time expressions as fargate %[1]s logs.`, source, logGroup),
+		Example: fmt.Sprintf(`
+fargate %[1]s logs query 'fields @timestamp, @message | filter @message like /ERROR/'
+fargate %[1]s logs query --stats "count(*) by bin(5m)" --start -6h
+fargate %[1]s logs query 'filter level = "error"' --stats "count(*) by msg" --format csv
+`, source),
+		Args: cobra.MaximumNArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			var query string
+			if len(args) > 0 {
+				query = args[0]
+			}
+
+			operation := &LogsQueryOperation{
+				LogGroupName: logGroupFor(),
+				Format:       flags.format,
+				Limit:        flags.limit,
+			}
+
+			operation.SetQuery(query, flags.stats)
+			operation.SetTimeRange(flags.startTime, flags.endTime)
+			operation.Validate()
+
+			logsQuery(operation)
+		},
+	}
+
+	cmd.Flags().StringVar(&flags.startTime, "start", defaultQueryStartTime, "Earliest time to query (e.g. -1h, 2018-01-01 09:36:00 EST")
+	cmd.Flags().StringVar(&flags.endTime, "end", "", "Latest time to query (defaults to now)")
+	cmd.Flags().StringVar(&flags.stats, "stats", "", `Stats expression to append to the query (e.g. "count(*) by bin(5m)")`)
+	cmd.Flags().StringVar(&flags.format, "format", queryFormatTable, "Output format (table, json or csv)")
+	cmd.Flags().Int64Var(&flags.limit, "limit", defaultQueryLimit, "Maximum number of results")
+
+	return cmd
+}
+
+type LogsQueryOperation struct {
+	LogGroupName string
+	Query        string
+	StartTime    time.Time
+	EndTime      time.Time
+	Format       string
+	Limit        int64
+}
+
+func (o *LogsQueryOperation) SetQuery(query, stats string) {
+	o.Query = buildInsightsQuery(query, stats)
+}
+
+func (o *LogsQueryOperation) SetTimeRange(rawStartTime, rawEndTime string) {
+	if rawStartTime == "" {
+		rawStartTime = defaultQueryStartTime
+	}
+
+	o.StartTime = parseTimeExpression(rawStartTime)
+	o.EndTime = time.Now()
+
+	if rawEndTime != "" {
+		o.EndTime = parseTimeExpression(rawEndTime)
+	}
+}
+
+func (o *LogsQueryOperation) Validate() {
+	if o.Format != queryFormatTable && o.Format != queryFormatJSON && o.Format != queryFormatCSV {
+		console.IssueExit("Invalid format %s (specify table, json or csv)", o.Format)
+	}
+
+	if !o.EndTime.After(o.StartTime) {
+		console.IssueExit("--end must be after --start")
+	}
+}
+
+// builds a Logs Insights query from a query and/or a stats expression
+func buildInsightsQuery(query, stats string) string {
+	query = strings.TrimSpace(query)
+	stats = strings.TrimSpace(stats)
+
+	switch {
+	case stats == "" && query == "":
+		return defaultInsightsQuery
+	case stats == "":
+		return query
+	case query == "":
+		return "stats " + stats
+	default:
+		return query + " | stats " + stats
+	}
+}
+
+func logsQuery(operation *LogsQueryOperation) {
+	cwl := CWL.New(sess)
+
+	console.Debug("Running query [%s] on %s", operation.Query, operation.LogGroupName)
+
+	results, err := cwl.Query(
+		&CWL.QueryInput{
+			LogGroupNames: []string{operation.LogGroupName},
+			QueryString:   operation.Query,
+			StartTime:     operation.StartTime,
+			EndTime:       operation.EndTime,
+			Limit:         operation.Limit,
+		},
+	)
+	if err != nil {
+		console.ErrorExit(err, "Could not run query")
+	}
+
+	switch operation.Format {
+	case queryFormatJSON:
+		bits, err := json.MarshalIndent(results.Rows, "", "  ")
+		if err != nil {
+			console.ErrorExit(err, "Could not marshal query results")
+		}
+
+		fmt.Println(string(bits))
+	case queryFormatCSV:
+		printQueryResultsCSV(results)
+	default:
+		printQueryResultsTable(results)
+	}
+}
+
+func printQueryResultsTable(results CWL.QueryResults) {
+	if len(results.Rows) == 0 {
+		console.Info("No results (%.0f records scanned)", results.RecordsScanned)
+		return
+	}
+
+	w := new(tabwriter.Writer)
+	w.Init(os.Stdout, 0, 8, 1, '\t', 0)
+	fmt.Fprintln(w, strings.Join(results.Fields, "\t")+"\t")
+
+	replacer := strings.NewReplacer("\t", " ", "\n", " ", "\r", "")
+
+	for _, row := range results.Rows {
+		var values []string
+
+		for _, field := range results.Fields {
+			values = append(values, replacer.Replace(row[field]))
+		}
+
+		fmt.Fprintln(w, strings.Join(values, "\t")+"\t")
+	}
+
+	w.Flush()
+
+	console.Info("%d results (%.0f records matched, %.0f records scanned)", len(results.Rows), results.RecordsMatched, results.RecordsScanned)
+}
+
+func printQueryResultsCSV(results CWL.QueryResults) {
+	w := csv.NewWriter(os.Stdout)
+	w.Write(results.Fields)
+
+	for _, row := range results.Rows {
+		var values []string
+
+		for _, field := range results.Fields {
+			values = append(values, row[field])
+		}
+
+		w.Write(values)
+	}
+
+	w.Flush()
+
+	if err := w.Error(); err != nil {
+		console.ErrorExit(err, "Could not write query results")
+	}
+}
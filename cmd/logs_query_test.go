@@ -0,0 +1,22 @@
+package cmd
+
+import "testing"
+
+func TestBuildInsightsQuery(t *testing.T) {
+	tests := []struct {
+		query    string
+		stats    string
+		expected string
+	}{
+		{"", "", defaultInsightsQuery},
+		{"fields @message", "", "fields @message"},
+		{"", "count(*) by bin(5m)", "stats count(*) by bin(5m)"},
+		{"filter level = 'error' ", " count(*) by msg", "filter level = 'error' | stats count(*) by msg"},
+	}
+
+	for _, test := range tests {
+		if got := buildInsightsQuery(test.query, test.stats); got != test.expected {
+			t.Errorf("Expected %s, got %s", test.expected, got)
+		}
+	}
+}
@@ -0,0 +1,228 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/spf13/cobra"
+	CWL "github.com/turnerlabs/fargate/cloudwatchlogs"
+	"github.com/turnerlabs/fargate/console"
+)
+
+const defaultSubscriptionFilterName = "fargate"
+
+var (
+	flagLogsSubscriptionServices      []string
+	flagLogsSubscriptionAllServices   bool
+	flagLogsSubscriptionName          string
+	flagLogsSubscriptionDestination   string
+	flagLogsSubscriptionFilterPattern string
+	flagLogsSubscriptionRole          string
+)
+
+type logsSubscriptionOperation struct {
+	Services       []string
+	AllServices    bool
+	Name           string
+	DestinationArn string
+	FilterPattern  string
+	Role           string
+}
+
+func (o *logsSubscriptionOperation) validate() {
+	if o.AllServices && len(o.Services) > 0 {
+		console.IssueExit("--service and --all-services cannot be used together")
+	}
+
+	if o.Name == "" {
+		console.IssueExit("--name cannot be empty")
+	}
+}
+
+func (o *logsSubscriptionOperation) validateDestination() {
+	if o.DestinationArn == "" {
+		console.IssueExit("--destination is required")
+	}
+
+	if err := validateSubscriptionDestination(o.DestinationArn, o.Role); err != nil {
+		console.ErrorExit(err, "Invalid destination")
+	}
+}
+
+// returns the services whose logs are subscribed: the given services, all
+// services of the cluster, or the configured service
+func (o *logsSubscriptionOperation) services() []string {
+	if o.AllServices {
+		return getClusterServiceNames()
+	}
+
+	if len(o.Services) > 0 {
+		return o.Services
+	}
+
+	return []string{getServiceName()}
+}
+
+var logsSubscriptionCmd = &cobra.Command{
+	Use:   "subscription",
+	Short: "Manage log subscription filters",
+	Long: `Manage log subscription filters
+
+Subscription filters forward the logs of services to a Lambda function or a
+Kinesis stream as they are ingested. Filters are added to the log groups of all
+containers of the services passed with --service (which can be specified
+multiple times), of all services in the cluster with --all-services, or of the
+service read from fargate.yml or environment variable options.`,
+}
+
+var logsSubscriptionAddCmd = &cobra.Command{
+	Use:   "add --destination <arn>",
+	Short: "Forward logs to a Lambda function or Kinesis stream",
+	Long: `Forward logs to a Lambda function or Kinesis stream
+
+Adds a subscription filter, or updates the filter with the same --name, to the
+log groups of services. --filter-pattern only forwards matching events.
+
+A Lambda function must allow CloudWatch Logs (logs.amazonaws.com) to invoke it.
+Kinesis and Firehose streams require --role, the name or ARN of a role that
+CloudWatch Logs can assume to write to the stream. A log group can have at most
+two subscription filters.`,
+	Example: `
+fargate logs subscription add --destination arn:aws:lambda:us-east-1:123456789012:function:ship-logs
+fargate logs subscription add --all-services --destination arn:aws:kinesis:us-east-1:123456789012:stream/logs --role cwl-to-kinesis
+fargate logs subscription add --service api --service worker --destination <arn> --filter-pattern ERROR
+`,
+	Run: func(cmd *cobra.Command, args []string) {
+		operation := &logsSubscriptionOperation{
+			Services:       flagLogsSubscriptionServices,
+			AllServices:    flagLogsSubscriptionAllServices,
+			Name:           flagLogsSubscriptionName,
+			DestinationArn: flagLogsSubscriptionDestination,
+			FilterPattern:  flagLogsSubscriptionFilterPattern,
+			Role:           flagLogsSubscriptionRole,
+		}
+
+		operation.validate()
+		operation.validateDestination()
+
+		addLogsSubscription(operation)
+	},
+}
+
+var logsSubscriptionRemoveCmd = &cobra.Command{
+	Use:   "remove",
+	Short: "Stop forwarding logs",
+	Long: `Stop forwarding logs
+
+Removes the subscription filter with the given --name from the log groups of
+services. Log groups without the filter are skipped.`,
+	Example: `
+fargate logs subscription remove
+fargate logs subscription remove --all-services --name ship-logs
+`,
+	Run: func(cmd *cobra.Command, args []string) {
+		operation := &logsSubscriptionOperation{
+			Services:    flagLogsSubscriptionServices,
+			AllServices: flagLogsSubscriptionAllServices,
+			Name:        flagLogsSubscriptionName,
+		}
+
+		operation.validate()
+
+		removeLogsSubscription(operation)
+	},
+}
+
+func init() {
+	logsSubscriptionCmd.PersistentFlags().StringSliceVarP(&flagLogsSubscriptionServices, "service", "s", []string{}, "Service whose logs are forwarded (can be specified multiple times)")
+	logsSubscriptionCmd.PersistentFlags().BoolVar(&flagLogsSubscriptionAllServices, "all-services", false, "Apply to all services in the cluster")
+	logsSubscriptionCmd.PersistentFlags().StringVar(&flagLogsSubscriptionName, "name", defaultSubscriptionFilterName, "Name of the subscription filter")
+
+	logsSubscriptionAddCmd.Flags().StringVar(&flagLogsSubscriptionDestination, "destination", "", "ARN of the Lambda function, Kinesis stream or Firehose delivery stream")
+	logsSubscriptionAddCmd.Flags().StringVar(&flagLogsSubscriptionFilterPattern, "filter-pattern", "", "Only forward events matching a filter pattern")
+	logsSubscriptionAddCmd.Flags().StringVar(&flagLogsSubscriptionRole, "role", "", "Name or ARN of the role CloudWatch Logs assumes to write to a stream")
+
+	logsSubscriptionCmd.AddCommand(logsSubscriptionAddCmd)
+	logsSubscriptionCmd.AddCommand(logsSubscriptionRemoveCmd)
+	logsCmd.AddCommand(logsSubscriptionCmd)
+}
+
+// checks that a destination is a Lambda function or stream, and that streams
+// have a role to write to them
+func validateSubscriptionDestination(destinationArn, role string) error {
+	destination, err := arn.Parse(destinationArn)
+	if err != nil {
+		return err
+	}
+
+	switch destination.Service {
+	case "lambda":
+		return nil
+	case "kinesis", "firehose":
+		if role == "" {
+			return fmt.Errorf("--role is required to forward logs to %s", destination.Service)
+		}
+
+		return nil
+	default:
+		return fmt.Errorf("%s is not a Lambda function, Kinesis stream or Firehose delivery stream", destinationArn)
+	}
+}
+
+func addLogsSubscription(op *logsSubscriptionOperation) {
+	cwl := CWL.New(sess)
+
+	filter := CWL.SubscriptionFilter{
+		Name:           op.Name,
+		FilterPattern:  op.FilterPattern,
+		DestinationArn: op.DestinationArn,
+	}
+
+	if op.Role != "" {
+		filter.RoleArn = resolveRoleArn(op.Role)
+	}
+
+	for _, service := range op.services() {
+		for _, logGroupName := range getServiceLogGroupNames(service) {
+			if err := cwl.PutSubscriptionFilter(logGroupName, filter); err != nil {
+				console.ErrorExit(err, "Could not add subscription filter %s to log group %s", filter.Name, logGroupName)
+			}
+
+			console.Info("Added subscription filter %s to log group %s", op.Name, logGroupName)
+		}
+	}
+}
+
+func removeLogsSubscription(op *logsSubscriptionOperation) {
+	cwl := CWL.New(sess)
+
+	for _, service := range op.services() {
+		for _, logGroupName := range getServiceLogGroupNames(service) {
+			filters, err := cwl.ListSubscriptionFilters(logGroupName)
+			if err != nil {
+				console.ErrorExit(err, "Could not list subscription filters of log group %s", logGroupName)
+			}
+
+			if !hasSubscriptionFilter(filters, op.Name) {
+				console.Debug("Log group %s has no subscription filter %s", logGroupName, op.Name)
+				continue
+			}
+
+			if err := cwl.DeleteSubscriptionFilter(logGroupName, op.Name); err != nil {
+				console.ErrorExit(err, "Could not remove subscription filter %s from log group %s", op.Name, logGroupName)
+			}
+
+			console.Info("Removed subscription filter %s from log group %s", op.Name, logGroupName)
+		}
+	}
+}
+
+func hasSubscriptionFilter(filters []CWL.SubscriptionFilter, name string) bool {
+	for _, filter := range filters {
+		if filter.Name == name {
+			return true
+		}
+	}
+
+	return false
+}
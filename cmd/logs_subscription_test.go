@@ -0,0 +1,30 @@
+package cmd
+
+import (
+	"testing"
+)
+
+func TestValidateSubscriptionDestination(t *testing.T) {
+	lambda := "arn:aws:lambda:us-east-1:123456789012:function:ship-logs"
+	kinesis := "arn:aws:kinesis:us-east-1:123456789012:stream/logs"
+
+	if err := validateSubscriptionDestination(lambda, ""); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+
+	if err := validateSubscriptionDestination(kinesis, "cwl-to-kinesis"); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+
+	if err := validateSubscriptionDestination(kinesis, ""); err == nil {
+		t.Error("Expected error for a stream without a role, got nil")
+	}
+
+	if err := validateSubscriptionDestination("arn:aws:sqs:us-east-1:123456789012:queue", ""); err == nil {
+		t.Error("Expected error for an unsupported destination, got nil")
+	}
+
+	if err := validateSubscriptionDestination("ship-logs", ""); err == nil {
+		t.Error("Expected error for an invalid ARN, got nil")
+	}
+}
@@ -0,0 +1,229 @@
+package cmd
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awscwl "github.com/aws/aws-sdk-go/service/cloudwatchlogs"
+	awsecs "github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/golang/mock/gomock"
+	"github.com/spf13/viper"
+	CWL "github.com/turnerlabs/fargate/cloudwatchlogs"
+	cwlsdk "github.com/turnerlabs/fargate/cloudwatchlogs/mock/sdk"
+	ECS "github.com/turnerlabs/fargate/ecs"
+	ecssdk "github.com/turnerlabs/fargate/ecs/mock/sdk"
+)
+
+func TestGetLogsOperationAddTasks(t *testing.T) {
+	operation := &GetLogsOperation{}
+	operation.AddLogSource("", ECS.LogConfiguration{
+		ContainerName: "job",
+		LogGroupName:  "/custom/my-job",
+		StreamPrefix:  "jobs",
+	})
+	operation.AddTasks([]string{"abc", "def"})
+
+	source := operation.Sources[0]
+
+	if source.LogGroupName != "/custom/my-job" {
+		t.Errorf("Expected /custom/my-job, got %s", source.LogGroupName)
+	}
+
+	if len(source.LogStreamNames) != 2 || source.LogStreamNames[0] != "jobs/job/abc" || source.LogStreamNames[1] != "jobs/job/def" {
+		t.Errorf("Expected [jobs/job/abc jobs/job/def], got %v", source.LogStreamNames)
+	}
+}
+
+func TestGetLogsOperationAddTasks_MultipleSources(t *testing.T) {
+	operation := &GetLogsOperation{}
+	operation.AddLogSource("app", ECS.LogConfiguration{
+		ContainerName: "app",
+		LogGroupName:  "/fargate/service/web",
+		StreamPrefix:  ECS.DefaultLogStreamPrefix,
+	})
+	operation.AddLogSource("nginx", ECS.LogConfiguration{
+		ContainerName: "nginx",
+		LogGroupName:  "/fargate/service/web",
+		StreamPrefix:  ECS.DefaultLogStreamPrefix,
+	})
+	operation.AddTasks([]string{"abc"})
+
+	if len(operation.Sources) != 2 {
+		t.Fatalf("Expected 2 sources, got %d", len(operation.Sources))
+	}
+
+	if operation.Sources[0].LogStreamPrefix != "fargate/app/" {
+		t.Errorf("Expected fargate/app/, got %s", operation.Sources[0].LogStreamPrefix)
+	}
+
+	if names := operation.Sources[1].LogStreamNames; len(names) != 1 || names[0] != "fargate/nginx/abc" {
+		t.Errorf("Expected [fargate/nginx/abc], got %v", names)
+	}
+}
+
+func TestSelectContainerLogConfigurations(t *testing.T) {
+	logConfigurations := []ECS.LogConfiguration{
+		ECS.LogConfiguration{ContainerName: "app"},
+		ECS.LogConfiguration{ContainerName: "nginx"},
+		ECS.LogConfiguration{ContainerName: "datadog"},
+	}
+
+	selected, err := selectContainerLogConfigurations(logConfigurations, []string{}, false)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if len(selected) != 1 || selected[0].ContainerName != "app" {
+		t.Errorf("Expected [app], got %v", selected)
+	}
+
+	selected, err = selectContainerLogConfigurations(logConfigurations, []string{"nginx", "app"}, false)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if len(selected) != 2 || selected[0].ContainerName != "nginx" || selected[1].ContainerName != "app" {
+		t.Errorf("Expected [nginx app], got %v", selected)
+	}
+
+	selected, err = selectContainerLogConfigurations(logConfigurations, []string{}, true)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if len(selected) != 3 {
+		t.Errorf("Expected 3 containers, got %d", len(selected))
+	}
+
+	if _, err := selectContainerLogConfigurations(logConfigurations, []string{"worker"}, false); err == nil {
+		t.Error("Expected error for unknown container, got nil")
+	}
+}
+
+func TestMergeLogLines(t *testing.T) {
+	now := time.Now()
+
+	logLines := mergeLogLines(
+		[]sourceLogLine{
+			sourceLogLine{Source: "api", LogLine: CWL.LogLine{EventId: "1", Timestamp: now}},
+			sourceLogLine{Source: "api", LogLine: CWL.LogLine{EventId: "2", Timestamp: now.Add(2 * time.Second)}},
+			sourceLogLine{Source: "worker", LogLine: CWL.LogLine{EventId: "3", Timestamp: now.Add(time.Second)}},
+			sourceLogLine{Source: "worker", LogLine: CWL.LogLine{EventId: "4", Timestamp: now}},
+		},
+	)
+
+	var ids string
+	for _, logLine := range logLines {
+		ids += logLine.EventId
+	}
+
+	if ids != "1432" {
+		t.Errorf("Expected 1432, got %s", ids)
+	}
+}
+
+func TestFetchLogs(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	events := map[string][]*awscwl.FilteredLogEvent{
+		"/fargate/service/api": []*awscwl.FilteredLogEvent{
+			&awscwl.FilteredLogEvent{EventId: aws.String("1"), Timestamp: aws.Int64(1000)},
+			&awscwl.FilteredLogEvent{EventId: aws.String("3"), Timestamp: aws.Int64(3000)},
+		},
+		"/fargate/service/worker": []*awscwl.FilteredLogEvent{
+			&awscwl.FilteredLogEvent{EventId: aws.String("2"), Timestamp: aws.Int64(2000)},
+		},
+	}
+
+	mockCWLClient := cwlsdk.NewMockCloudWatchLogsAPI(mockCtrl)
+	mockCWLClient.EXPECT().FilterLogEventsPages(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(input *awscwl.FilterLogEventsInput, fn func(*awscwl.FilterLogEventsOutput, bool) bool) error {
+			fn(&awscwl.FilterLogEventsOutput{Events: events[aws.StringValue(input.LogGroupName)]}, true)
+			return nil
+		},
+	).Times(2)
+
+	operation := &GetLogsOperation{cwl: CWL.NewWithClient(mockCWLClient)}
+	operation.AddLogSource("api", ECS.LogConfiguration{ContainerName: "api", LogGroupName: "/fargate/service/api", StreamPrefix: "fargate"})
+	operation.AddLogSource("worker", ECS.LogConfiguration{ContainerName: "worker", LogGroupName: "/fargate/service/worker", StreamPrefix: "fargate"})
+
+	var lines string
+	for _, logLine := range fetchLogs(operation) {
+		lines += logLine.Source + ":" + logLine.EventId + " "
+	}
+
+	if expected := "api:1 worker:2 api:3 "; lines != expected {
+		t.Errorf("Expected %s, got %s", expected, lines)
+	}
+}
+
+func TestAddServiceLogSources(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	viper.Set(keyCluster, "my-cluster")
+	defer viper.Set(keyCluster, "")
+
+	logConfiguration := &awsecs.LogConfiguration{
+		LogDriver: aws.String(awsecs.LogDriverAwslogs),
+		Options: aws.StringMap(map[string]string{
+			"awslogs-group":         "/custom/web",
+			"awslogs-stream-prefix": "web",
+		}),
+	}
+
+	mockECSClient := ecssdk.NewMockECSAPI(mockCtrl)
+	expectDescribeService(mockECSClient, "web", "arn:aws:ecs:us-east-1:123456789012:task-definition/log-sources:1",
+		&awsecs.ContainerDefinition{Name: aws.String("app"), LogConfiguration: logConfiguration},
+		&awsecs.ContainerDefinition{Name: aws.String("nginx"), LogConfiguration: logConfiguration},
+	)
+
+	ecs := ECS.NewWithClient(mockECSClient, "my-cluster")
+	operation := &GetLogsOperation{ecs: &ecs}
+	operation.AddServiceLogSources("web", []string{}, true, true)
+
+	if len(operation.Sources) != 2 {
+		t.Fatalf("Expected 2 sources, got %d", len(operation.Sources))
+	}
+
+	source := operation.Sources[1]
+
+	if source.Name != "web/nginx" {
+		t.Errorf("Expected web/nginx, got %s", source.Name)
+	}
+
+	if source.LogGroupName != "/custom/web" {
+		t.Errorf("Expected /custom/web, got %s", source.LogGroupName)
+	}
+
+	if source.LogStreamPrefix != "web/nginx/" {
+		t.Errorf("Expected web/nginx/, got %s", source.LogStreamPrefix)
+	}
+}
+
+func TestAddServiceLogSourcesWithoutCluster(t *testing.T) {
+	viper.Set(keyCluster, "")
+
+	operation := &GetLogsOperation{}
+	operation.AddServiceLogSources("web", []string{}, false, true)
+
+	if len(operation.Sources) != 1 {
+		t.Fatalf("Expected 1 source, got %d", len(operation.Sources))
+	}
+
+	source := operation.Sources[0]
+
+	if source.Name != "web" {
+		t.Errorf("Expected web, got %s", source.Name)
+	}
+
+	if source.LogGroupName != "/fargate/service/web" {
+		t.Errorf("Expected /fargate/service/web, got %s", source.LogGroupName)
+	}
+
+	if source.LogStreamPrefix != "fargate/web/" {
+		t.Errorf("Expected fargate/web/, got %s", source.LogStreamPrefix)
+	}
+}
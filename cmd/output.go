@@ -16,10 +16,12 @@ var (
 	warning = emoji.Sprintf(" :warning: ")
 
 	blue   = ansi.ColorCode("blue+bh")
+	green  = ansi.ColorCode("green+bh")
 	orange = ansi.ColorCode("214+bh")
 	red    = ansi.ColorCode("red+bh")
 	reset  = ansi.ColorCode("reset")
 	white  = ansi.ColorCode("white+bh")
+	yellow = ansi.ColorCode("yellow+bh")
 )
 
 // Output represents a channel for sending messages to a user.
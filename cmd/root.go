@@ -6,15 +6,19 @@ import (
 	"os"
 	"regexp"
 	"runtime"
+	"sort"
 	"strconv"
 	"strings"
 
-	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
 	"github.com/aws/aws-sdk-go/aws/session"
+	awsecs "github.com/aws/aws-sdk-go/service/ecs"
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 	"github.com/turnerlabs/fargate/console"
 	ECS "github.com/turnerlabs/fargate/ecs"
+	STS "github.com/turnerlabs/fargate/sts"
 	"golang.org/x/crypto/ssh/terminal"
 )
 
@@ -32,6 +36,9 @@ const (
 	validRuleTypesPattern = "(?i)^host|path$"
 
 	describeRequestLimitRate = 10
+
+	//marks commands directly under the root that need an AWS session
+	annotationRequiresSession = "requires-session"
 )
 
 var InvalidCpuAndMemoryCombination = fmt.Errorf(`Invalid CPU and Memory settings
@@ -45,24 +52,14 @@ CPU (CPU Units)    Memory (MiB)
 4096               8192 through 30720 in 1GiB increments
 `)
 
-var validRegions = []string{
-	"us-east-1",
-	"us-east-2",
-	"us-west-1",
-	"us-west-2",
-	"ca-central-1",
-	"eu-west-1",
-	"eu-west-2",
-	"eu-central-1",
-	"ap-southeast-1",
-	"ap-southeast-2",
-	"ap-northeast-1",
-	"ap-northheast-2",
-	"ap-south-1",
-}
-
 var (
 	clusterName string
+	environment string
+	profile     string
+	roleArn     string
+	externalID  string
+	mfaSerial   string
+	endpointURL string
 	noColor     bool
 	noEmoji     bool
 	output      ConsoleOutput
@@ -70,6 +67,8 @@ var (
 	sess        *session.Session
 	verbose     bool
 	identifier  *regexp.Regexp
+
+	roleSessionName string
 )
 
 var rootCmd = &cobra.Command{
@@ -86,7 +85,11 @@ CloudWatch Logs, and Amazon Route 53 into an easy-to-use CLI.`,
 	PersistentPreRun: func(cmd *cobra.Command, args []string) {
 		output = ConsoleOutput{}
 
-		if cmd.Parent().Name() == "fargate" {
+		if err := applyEnvironment(viper.GetViper(), getEnvironment()); err != nil {
+			console.ErrorExit(err, "Invalid environment")
+		}
+
+		if cmd.Parent().Name() == "fargate" && cmd.Annotations[annotationRequiresSession] == "" {
 			return
 		}
 
@@ -106,27 +109,27 @@ CloudWatch Logs, and Amazon Route 53 into an easy-to-use CLI.`,
 		}
 
 		region = getRegion()
+		endpoints := getEndpoints()
 
-		if err := validateRegion(region); err != nil {
-			console.IssueExit(err.Error())
+		if err := endpoints.validate(); err != nil {
+			console.ErrorExit(err, "Invalid endpoint")
 		}
 
-		if getVerbose() {
-			sess = session.Must(
-				session.NewSessionWithOptions(session.Options{
-					SharedConfigState: session.SharedConfigEnable,
-					Config:            aws.Config{Region: aws.String(region), LogLevel: aws.LogLevel(aws.LogDebugWithHTTPBody)},
-				}),
-			)
-		} else {
-			sess = session.Must(
-				session.NewSessionWithOptions(session.Options{
-					SharedConfigState: session.SharedConfigEnable,
-					Config:            aws.Config{Region: aws.String(region)},
-				}),
-			)
+		//stand-ins such as LocalStack accept any region
+		if !endpoints.enabled() {
+			if err := validateRegion(region); err != nil {
+				console.IssueExit(err.Error())
+			}
+		}
+
+		role := getAssumeRole()
+
+		if err := role.validate(); err != nil {
+			console.ErrorExit(err, "Invalid command line flags")
 		}
 
+		sess = newSession(region, getProfile(), role, endpoints, getVerbose())
+
 		_, err := sess.Config.Credentials.Get()
 
 		if aerr, ok := err.(awserr.Error); ok {
@@ -142,6 +145,16 @@ CloudWatch Logs, and Amazon Route 53 into an easy-to-use CLI.`,
 				console.ErrorExit(err, "Could not create create AWS session")
 			}
 		}
+
+		if getVerbose() {
+			sts := STS.New(sess)
+			identity, err := sts.GetCallerIdentity()
+			if err != nil {
+				console.ErrorExit(err, "Error calling GetCallerIdentity")
+			}
+
+			console.Debug("Using identity %s in account %s", identity.ARN, identity.Account)
+		}
 	},
 }
 
@@ -157,6 +170,13 @@ func init() {
 	rootCmd.PersistentFlags().BoolVarP(&verbose, "verbose", "v", false, "Verbose output")
 	rootCmd.PersistentFlags().BoolVar(&noColor, "nocolor", false, "Disable color output")
 	rootCmd.PersistentFlags().StringVarP(&clusterName, "cluster", "c", "", `ECS cluster name`)
+	rootCmd.PersistentFlags().StringVar(&environment, "env", "", `Environment of fargate.yml to use`)
+	rootCmd.PersistentFlags().StringVar(&profile, "profile", "", `AWS shared configuration profile`)
+	rootCmd.PersistentFlags().StringVar(&roleArn, "role-arn", "", `ARN of an IAM role to assume`)
+	rootCmd.PersistentFlags().StringVar(&externalID, "external-id", "", `External ID required to assume the role`)
+	rootCmd.PersistentFlags().StringVar(&roleSessionName, "role-session-name", "", `Session name of the assumed role`)
+	rootCmd.PersistentFlags().StringVar(&mfaSerial, "mfa-serial", "", `Serial number or ARN of the MFA device required to assume the role`)
+	rootCmd.PersistentFlags().StringVar(&endpointURL, "endpoint-url", "", `URL of the endpoint of all AWS services (e.g. LocalStack)`)
 
 	if runtime.GOOS == runtimeMacOS {
 		rootCmd.PersistentFlags().BoolVar(&noEmoji, "no-emoji", false, "Disable emoji output")
@@ -169,10 +189,6 @@ func init() {
 func extractEnvVars(inputEnvVars []string) []ECS.EnvVar {
 	var envVars []ECS.EnvVar
 
-	if identifier == nil {
-		identifier = regexp.MustCompile("^[a-zA-Z_][a-zA-Z0-9_]*$")
-	}
-
 	if len(inputEnvVars) == 0 {
 		return envVars
 	}
@@ -187,8 +203,8 @@ func extractEnvVars(inputEnvVars []string) []ECS.EnvVar {
 		key, value := splitInputEnvVar[0], splitInputEnvVar[1]
 
 		// make sure the key portion is a valid identifier
-		if !identifier.MatchString(key) {
-			console.ErrorExit(fmt.Errorf("Environment variable name %s must contain only letters, underscores, and digits", key), "Invalid environment variable")
+		if err := validateEnvVarName(key); err != nil {
+			console.ErrorExit(err, "Invalid environment variable")
 		}
 
 		envVar := ECS.EnvVar{
@@ -202,6 +218,19 @@ func extractEnvVars(inputEnvVars []string) []ECS.EnvVar {
 	return envVars
 }
 
+// validateEnvVarName makes sure an environment variable name is a valid identifier
+func validateEnvVarName(key string) error {
+	if identifier == nil {
+		identifier = regexp.MustCompile("^[a-zA-Z_][a-zA-Z0-9_]*$")
+	}
+
+	if !identifier.MatchString(key) {
+		return fmt.Errorf("Environment variable name %s must contain only letters, underscores, and digits", key)
+	}
+
+	return nil
+}
+
 func readVarFile(filename string) []string {
 	var result []string
 
@@ -261,16 +290,26 @@ func validateMebibytes(mebibytes, min, max int64) bool {
 	return mebibytes >= min && mebibytes <= max && mebibytes%mebibytesInGibibyte == 0
 }
 
+//validates that a region is known to the SDK, in any partition, and has ECS
 func validateRegion(region string) error {
-	found := false
-	for _, validRegion := range validRegions {
-		if region == validRegion {
-			found = true
-			break
+	var regions []string
+
+	for _, partition := range endpoints.DefaultPartitions() {
+		service, ok := partition.Services()[awsecs.EndpointsID]
+		if !ok {
+			continue
+		}
+
+		for id := range service.Regions() {
+			if id == region {
+				return nil
+			}
+
+			regions = append(regions, id)
 		}
 	}
-	if !found {
-		return fmt.Errorf("Invalid region: %s [valid regions: %s]", region, strings.Join(validRegions, ", "))
-	}
-	return nil
+
+	sort.Strings(regions)
+
+	return fmt.Errorf("Invalid region: %s [valid regions: %s]", region, strings.Join(regions, ", "))
 }
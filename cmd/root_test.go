@@ -106,6 +106,12 @@ func TestRegion(t *testing.T) {
 		t.Error(err)
 	}
 
+	for _, region := range []string{"ap-northeast-2", "eu-north-1", "us-gov-west-1", "cn-north-1"} {
+		if err := validateRegion(region); err != nil {
+			t.Error(err)
+		}
+	}
+
 }
 
 func TestRegion_Invalid(t *testing.T) {
@@ -114,4 +120,8 @@ func TestRegion_Invalid(t *testing.T) {
 	if err == nil {
 		t.Error("expecting invalid region")
 	}
+
+	if err := validateRegion("ap-northheast-2"); err == nil {
+		t.Error("expecting invalid region")
+	}
 }
\ No newline at end of file
@@ -0,0 +1,403 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/arn"
+	awscw "github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/spf13/cobra"
+	CW "github.com/turnerlabs/fargate/cloudwatch"
+	CWL "github.com/turnerlabs/fargate/cloudwatchlogs"
+	"github.com/turnerlabs/fargate/console"
+)
+
+const (
+	alarmNameFormat      = "fargate/%s/%s"
+	alarmLogNamespace    = "fargate"
+	alarmECSNamespace    = "AWS/ECS"
+	alarmDefaultPeriod   = "5m"
+	alarmMetricLogs      = "logs"
+	alarmMetricCPU       = "cpu"
+	alarmMetricMemory    = "memory"
+	alarmDefaultLogsName = "errors"
+)
+
+var (
+	flagServiceAlarmName              string
+	flagServiceAlarmLogPattern        string
+	flagServiceAlarmCPU               bool
+	flagServiceAlarmMemory            bool
+	flagServiceAlarmThreshold         float64
+	flagServiceAlarmPeriod            string
+	flagServiceAlarmEvaluationPeriods int64
+	flagServiceAlarmSNS               []string
+)
+
+type serviceAlarmAddOperation struct {
+	ServiceName       string
+	ClusterName       string
+	Name              string
+	LogPattern        string
+	CPU               bool
+	Memory            bool
+	Threshold         float64
+	Period            time.Duration
+	EvaluationPeriods int64
+	SNSTopicArns      []string
+}
+
+// returns which metric the alarm watches: matching log events, CPU or memory
+func (o *serviceAlarmAddOperation) metric() string {
+	switch {
+	case o.CPU:
+		return alarmMetricCPU
+	case o.Memory:
+		return alarmMetricMemory
+	default:
+		return alarmMetricLogs
+	}
+}
+
+func (o *serviceAlarmAddOperation) validate() error {
+	set := 0
+
+	for _, isSet := range []bool{o.LogPattern != "", o.CPU, o.Memory} {
+		if isSet {
+			set++
+		}
+	}
+
+	if set != 1 {
+		return fmt.Errorf("exactly one of --log-pattern, --cpu or --memory is required")
+	}
+
+	if o.EvaluationPeriods < 1 {
+		return fmt.Errorf("--evaluation-periods must be at least 1")
+	}
+
+	for _, topicArn := range o.SNSTopicArns {
+		topic, err := arn.Parse(topicArn)
+		if err != nil {
+			return err
+		}
+
+		if topic.Service != "sns" {
+			return fmt.Errorf("%s is not an SNS topic", topicArn)
+		}
+	}
+
+	return nil
+}
+
+// returns the alarm to create, and the metric filter counting matching log
+// events for log pattern alarms
+func (o *serviceAlarmAddOperation) alarm() (CW.Alarm, *CWL.MetricFilter) {
+	name := o.Name
+	if name == "" {
+		name = o.metric()
+		if name == alarmMetricLogs {
+			name = alarmDefaultLogsName
+		}
+	}
+
+	alarm := CW.Alarm{
+		Name:               serviceAlarmName(o.ServiceName, name),
+		Period:             int64(o.Period / time.Second),
+		EvaluationPeriods:  o.EvaluationPeriods,
+		Threshold:          o.Threshold,
+		ComparisonOperator: awscw.ComparisonOperatorGreaterThanOrEqualToThreshold,
+		AlarmActions:       o.SNSTopicArns,
+	}
+
+	switch o.metric() {
+	case alarmMetricLogs:
+		filter := &CWL.MetricFilter{
+			Name:            alarm.Name,
+			FilterPattern:   o.LogPattern,
+			MetricNamespace: alarmLogNamespace,
+			MetricName:      fmt.Sprintf("%s/%s", o.ServiceName, name),
+		}
+
+		alarm.Description = fmt.Sprintf("Log events of service %s matching %s", o.ServiceName, o.LogPattern)
+		alarm.Namespace = filter.MetricNamespace
+		alarm.MetricName = filter.MetricName
+		alarm.Statistic = awscw.StatisticSum
+		alarm.TreatMissingData = "notBreaching"
+
+		return alarm, filter
+	case alarmMetricCPU:
+		alarm.Description = fmt.Sprintf("CPU utilization of service %s", o.ServiceName)
+		alarm.MetricName = "CPUUtilization"
+	case alarmMetricMemory:
+		alarm.Description = fmt.Sprintf("Memory utilization of service %s", o.ServiceName)
+		alarm.MetricName = "MemoryUtilization"
+	}
+
+	alarm.Namespace = alarmECSNamespace
+	alarm.Statistic = awscw.StatisticAverage
+	alarm.Dimensions = map[string]string{
+		"ClusterName": o.ClusterName,
+		"ServiceName": o.ServiceName,
+	}
+
+	return alarm, nil
+}
+
+var serviceAlarmCmd = &cobra.Command{
+	Use:   "alarm",
+	Short: "Manage service alarms",
+	Long: `Manage service alarms
+
+Alarms notify SNS topics when the number of log events matching a pattern, or
+the CPU or memory utilization of a service, reaches a threshold. Alarms are
+named fargate/<service-name>/<name>.`,
+}
+
+var serviceAlarmAddCmd = &cobra.Command{
+	Use:   "add (--log-pattern <pattern> | --cpu | --memory) --threshold <value>",
+	Short: "Add an alarm to a service",
+	Long: `Add an alarm to a service
+
+--log-pattern creates a metric filter on the log groups of the service counting
+the events matching a CloudWatch Logs filter pattern, and an alarm on the sum of
+that count. --cpu and --memory create an alarm on the average CPU or memory
+utilization (in percent) of the service.
+
+The alarm goes off when the value reaches --threshold for --evaluation-periods
+consecutive periods of --period (e.g. 1m, 5m; default 5m), and notifies the SNS
+topics passed with --sns (which can be specified multiple times). The alarm is
+named after --name, which defaults to errors, cpu or memory; adding an alarm
+with an existing name updates it.`,
+	Example: `
+fargate service alarm add --log-pattern ERROR --threshold 10 --period 5m --sns arn:aws:sns:us-east-1:123456789012:alerts
+fargate service alarm add --name timeouts --log-pattern '"upstream timed out"' --threshold 1 --sns <arn>
+fargate service alarm add --cpu --threshold 80 --evaluation-periods 3 --sns <arn>
+`,
+	Run: func(cmd *cobra.Command, args []string) {
+		if !cmd.Flags().Changed("threshold") {
+			console.IssueExit("--threshold is required")
+		}
+
+		period, err := parseAlarmPeriod(flagServiceAlarmPeriod)
+		if err != nil {
+			console.ErrorExit(err, "Invalid period")
+		}
+
+		operation := &serviceAlarmAddOperation{
+			ServiceName:       getServiceName(),
+			ClusterName:       getClusterName(),
+			Name:              flagServiceAlarmName,
+			LogPattern:        flagServiceAlarmLogPattern,
+			CPU:               flagServiceAlarmCPU,
+			Memory:            flagServiceAlarmMemory,
+			Threshold:         flagServiceAlarmThreshold,
+			Period:            period,
+			EvaluationPeriods: flagServiceAlarmEvaluationPeriods,
+			SNSTopicArns:      flagServiceAlarmSNS,
+		}
+
+		if err := operation.validate(); err != nil {
+			console.ErrorExit(err, "Invalid command line flags")
+		}
+
+		addServiceAlarm(operation)
+	},
+}
+
+var serviceAlarmListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List the alarms of a service",
+	Run: func(cmd *cobra.Command, args []string) {
+		listServiceAlarms(getServiceName())
+	},
+}
+
+var serviceAlarmRemoveCmd = &cobra.Command{
+	Use:   "remove <name>",
+	Short: "Remove an alarm from a service",
+	Long: `Remove an alarm from a service
+
+Removes the alarm with the given name, as shown by fargate service alarm list,
+and the metric filters of log pattern alarms.`,
+	Example: `
+fargate service alarm remove errors
+fargate service alarm remove cpu
+`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		removeServiceAlarm(getServiceName(), args[0])
+	},
+}
+
+func init() {
+	serviceAlarmAddCmd.Flags().StringVar(&flagServiceAlarmName, "name", "", "Name of the alarm (default errors, cpu or memory)")
+	serviceAlarmAddCmd.Flags().StringVar(&flagServiceAlarmLogPattern, "log-pattern", "", "Alarm on the number of log events matching a filter pattern")
+	serviceAlarmAddCmd.Flags().BoolVar(&flagServiceAlarmCPU, "cpu", false, "Alarm on the CPU utilization of the service")
+	serviceAlarmAddCmd.Flags().BoolVar(&flagServiceAlarmMemory, "memory", false, "Alarm on the memory utilization of the service")
+	serviceAlarmAddCmd.Flags().Float64Var(&flagServiceAlarmThreshold, "threshold", 0, "Value at or above which the alarm goes off")
+	serviceAlarmAddCmd.Flags().StringVar(&flagServiceAlarmPeriod, "period", alarmDefaultPeriod, "Period over which the metric is evaluated (e.g. 1m, 5m)")
+	serviceAlarmAddCmd.Flags().Int64Var(&flagServiceAlarmEvaluationPeriods, "evaluation-periods", 1, "Number of consecutive periods at or above the threshold")
+	serviceAlarmAddCmd.Flags().StringSliceVar(&flagServiceAlarmSNS, "sns", []string{}, "ARN of an SNS topic to notify (can be specified multiple times)")
+
+	serviceAlarmCmd.AddCommand(serviceAlarmAddCmd)
+	serviceAlarmCmd.AddCommand(serviceAlarmListCmd)
+	serviceAlarmCmd.AddCommand(serviceAlarmRemoveCmd)
+	serviceCmd.AddCommand(serviceAlarmCmd)
+}
+
+func serviceAlarmName(serviceName, name string) string {
+	return fmt.Sprintf(alarmNameFormat, serviceName, name)
+}
+
+// parses an alarm period; CloudWatch supports 10 seconds, 30 seconds, and
+// multiples of a minute
+func parseAlarmPeriod(raw string) (time.Duration, error) {
+	period, err := time.ParseDuration(raw)
+	if err != nil {
+		return 0, err
+	}
+
+	if period != 10*time.Second && period != 30*time.Second && (period < time.Minute || period%time.Minute != 0) {
+		return 0, fmt.Errorf("%s is not 10s, 30s or a multiple of 1m", raw)
+	}
+
+	return period, nil
+}
+
+func addServiceAlarm(op *serviceAlarmAddOperation) {
+	alarm, filter := op.alarm()
+
+	if filter != nil {
+		cwl := CWL.New(sess)
+
+		for _, logGroupName := range getServiceLogGroupNames(op.ServiceName) {
+			if err := cwl.PutMetricFilter(logGroupName, *filter); err != nil {
+				console.ErrorExit(err, "Could not add metric filter %s to log group %s", filter.Name, logGroupName)
+			}
+
+			console.Info("Added metric filter %s to log group %s", filter.Name, logGroupName)
+		}
+	}
+
+	cw := CW.New(sess)
+	if err := cw.PutMetricAlarm(alarm); err != nil {
+		console.ErrorExit(err, "Could not create alarm %s", alarm.Name)
+	}
+
+	console.Info("Added alarm %s", alarm.Name)
+}
+
+func listServiceAlarms(serviceName string) {
+	cw := CW.New(sess)
+	alarms, err := cw.DescribeAlarms(serviceAlarmName(serviceName, ""))
+	if err != nil {
+		console.ErrorExit(err, "Could not list alarms")
+	}
+
+	if len(alarms) == 0 {
+		console.Info("No alarms found")
+		return
+	}
+
+	w := new(tabwriter.Writer)
+	w.Init(os.Stdout, 0, 8, 1, '\t', 0)
+	fmt.Fprintln(w, "NAME\tMETRIC\tCONDITION\tSTATE\tNOTIFY\t")
+
+	for _, alarm := range alarms {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t\n",
+			strings.TrimPrefix(alarm.Name, serviceAlarmName(serviceName, "")),
+			formatAlarmMetric(alarm),
+			formatAlarmCondition(alarm),
+			alarm.State,
+			strings.Join(alarm.AlarmActions, ", "),
+		)
+	}
+
+	w.Flush()
+}
+
+func removeServiceAlarm(serviceName, name string) {
+	alarmName := serviceAlarmName(serviceName, name)
+
+	cw := CW.New(sess)
+	alarms, err := cw.DescribeAlarms(alarmName)
+	if err != nil {
+		console.ErrorExit(err, "Could not list alarms")
+	}
+
+	if !hasAlarm(alarms, alarmName) {
+		console.IssueExit("Could not find alarm %s", alarmName)
+	}
+
+	cwl := CWL.New(sess)
+
+	for _, logGroupName := range getServiceLogGroupNames(serviceName) {
+		filters, err := cwl.ListMetricFilters(logGroupName)
+		if err != nil {
+			console.ErrorExit(err, "Could not list metric filters of log group %s", logGroupName)
+		}
+
+		for _, filter := range filters {
+			if filter.Name == alarmName {
+				if err := cwl.DeleteMetricFilter(logGroupName, filter.Name); err != nil {
+					console.ErrorExit(err, "Could not remove metric filter %s from log group %s", filter.Name, logGroupName)
+				}
+
+				console.Info("Removed metric filter %s from log group %s", filter.Name, logGroupName)
+			}
+		}
+	}
+
+	if err := cw.DeleteAlarm(alarmName); err != nil {
+		console.ErrorExit(err, "Could not remove alarm %s", alarmName)
+	}
+
+	console.Info("Removed alarm %s", alarmName)
+}
+
+func hasAlarm(alarms []CW.Alarm, name string) bool {
+	for _, alarm := range alarms {
+		if alarm.Name == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+func formatAlarmMetric(alarm CW.Alarm) string {
+	if alarm.Namespace == alarmLogNamespace {
+		return "log events"
+	}
+
+	return alarm.MetricName
+}
+
+func formatAlarmCondition(alarm CW.Alarm) string {
+	var operator string
+
+	switch alarm.ComparisonOperator {
+	case awscw.ComparisonOperatorGreaterThanOrEqualToThreshold:
+		operator = ">="
+	case awscw.ComparisonOperatorGreaterThanThreshold:
+		operator = ">"
+	case awscw.ComparisonOperatorLessThanThreshold:
+		operator = "<"
+	case awscw.ComparisonOperatorLessThanOrEqualToThreshold:
+		operator = "<="
+	default:
+		operator = alarm.ComparisonOperator
+	}
+
+	condition := fmt.Sprintf("%s %s %g for %s", strings.ToLower(alarm.Statistic), operator, alarm.Threshold, time.Duration(alarm.Period)*time.Second)
+
+	if alarm.EvaluationPeriods > 1 {
+		condition += fmt.Sprintf(" x %d", alarm.EvaluationPeriods)
+	}
+
+	return condition
+}
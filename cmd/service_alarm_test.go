@@ -0,0 +1,221 @@
+package cmd
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awscw "github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/golang/mock/gomock"
+	CW "github.com/turnerlabs/fargate/cloudwatch"
+	cwsdk "github.com/turnerlabs/fargate/cloudwatch/mock/sdk"
+)
+
+func TestParseAlarmPeriod(t *testing.T) {
+	tests := map[string]time.Duration{
+		"10s": 10 * time.Second,
+		"30s": 30 * time.Second,
+		"1m":  time.Minute,
+		"5m":  5 * time.Minute,
+		"1h":  time.Hour,
+	}
+
+	for raw, expected := range tests {
+		period, err := parseAlarmPeriod(raw)
+		if err != nil {
+			t.Errorf("Unexpected error for %s: %v", raw, err)
+		}
+
+		if period != expected {
+			t.Errorf("Expected %s for %s, got %s", expected, raw, period)
+		}
+	}
+
+	for _, raw := range []string{"20s", "90s", "5", "five minutes"} {
+		if _, err := parseAlarmPeriod(raw); err == nil {
+			t.Errorf("Expected error for %s, got nil", raw)
+		}
+	}
+}
+
+func TestServiceAlarmAddValidate(t *testing.T) {
+	tests := []struct {
+		operation serviceAlarmAddOperation
+		valid     bool
+	}{
+		{serviceAlarmAddOperation{LogPattern: "ERROR", EvaluationPeriods: 1}, true},
+		{serviceAlarmAddOperation{CPU: true, EvaluationPeriods: 1, SNSTopicArns: []string{"arn:aws:sns:us-east-1:123456789012:alerts"}}, true},
+		{serviceAlarmAddOperation{EvaluationPeriods: 1}, false},
+		{serviceAlarmAddOperation{CPU: true, Memory: true, EvaluationPeriods: 1}, false},
+		{serviceAlarmAddOperation{Memory: true, EvaluationPeriods: 0}, false},
+		{serviceAlarmAddOperation{CPU: true, EvaluationPeriods: 1, SNSTopicArns: []string{"arn:aws:sqs:us-east-1:123456789012:alerts"}}, false},
+	}
+
+	for i, test := range tests {
+		if err := test.operation.validate(); (err == nil) != test.valid {
+			t.Errorf("Expected valid %t for test %d, got %v", test.valid, i, err)
+		}
+	}
+}
+
+func TestServiceAlarmLogPattern(t *testing.T) {
+	operation := &serviceAlarmAddOperation{
+		ServiceName:       "api",
+		LogPattern:        "ERROR",
+		Threshold:         10,
+		Period:            5 * time.Minute,
+		EvaluationPeriods: 1,
+	}
+
+	alarm, filter := operation.alarm()
+
+	if filter == nil {
+		t.Fatal("Expected a metric filter")
+	}
+
+	if alarm.Name != "fargate/api/errors" || filter.Name != alarm.Name {
+		t.Errorf("Expected fargate/api/errors, got %s and %s", alarm.Name, filter.Name)
+	}
+
+	if alarm.Namespace != filter.MetricNamespace || alarm.MetricName != filter.MetricName {
+		t.Errorf("Expected the alarm on %s %s, got %s %s", filter.MetricNamespace, filter.MetricName, alarm.Namespace, alarm.MetricName)
+	}
+
+	if alarm.Period != 300 || alarm.Statistic != "Sum" {
+		t.Errorf("Expected Sum over 300, got %s over %d", alarm.Statistic, alarm.Period)
+	}
+}
+
+func TestServiceAlarmCPU(t *testing.T) {
+	operation := &serviceAlarmAddOperation{
+		ServiceName:       "api",
+		ClusterName:       "prod",
+		CPU:               true,
+		Threshold:         80,
+		Period:            time.Minute,
+		EvaluationPeriods: 3,
+	}
+
+	alarm, filter := operation.alarm()
+
+	if filter != nil {
+		t.Errorf("Expected no metric filter, got %v", filter)
+	}
+
+	if alarm.Name != "fargate/api/cpu" || alarm.MetricName != "CPUUtilization" {
+		t.Errorf("Expected fargate/api/cpu on CPUUtilization, got %s on %s", alarm.Name, alarm.MetricName)
+	}
+
+	if alarm.Dimensions["ClusterName"] != "prod" || alarm.Dimensions["ServiceName"] != "api" {
+		t.Errorf("Expected dimensions of prod/api, got %v", alarm.Dimensions)
+	}
+
+	if got := formatAlarmCondition(alarm); got != "average >= 80 for 1m0s x 3" {
+		t.Errorf("Expected average >= 80 for 1m0s x 3, got %s", got)
+	}
+}
+
+func TestServiceAlarmPutMetricAlarmInput(t *testing.T) {
+	tests := []struct {
+		operation  serviceAlarmAddOperation
+		namespace  string
+		metricName string
+		statistic  string
+		missing    string
+		dimensions []string
+	}{
+		{
+			operation:  serviceAlarmAddOperation{LogPattern: "ERROR", Threshold: 10},
+			namespace:  "fargate",
+			metricName: "api/errors",
+			statistic:  "Sum",
+			missing:    "notBreaching",
+		},
+		{
+			operation:  serviceAlarmAddOperation{CPU: true, Threshold: 80},
+			namespace:  "AWS/ECS",
+			metricName: "CPUUtilization",
+			statistic:  "Average",
+			dimensions: []string{"ClusterName=prod", "ServiceName=api"},
+		},
+		{
+			operation:  serviceAlarmAddOperation{Memory: true, Threshold: 90},
+			namespace:  "AWS/ECS",
+			metricName: "MemoryUtilization",
+			statistic:  "Average",
+			dimensions: []string{"ClusterName=prod", "ServiceName=api"},
+		},
+	}
+
+	for _, test := range tests {
+		mockCtrl := gomock.NewController(t)
+		mockCWClient := cwsdk.NewMockCloudWatchAPI(mockCtrl)
+
+		operation := test.operation
+		operation.ServiceName = "api"
+		operation.ClusterName = "prod"
+		operation.Period = 5 * time.Minute
+		operation.EvaluationPeriods = 2
+		operation.SNSTopicArns = []string{"arn:aws:sns:us-east-1:123456789012:alerts"}
+
+		var input *awscw.PutMetricAlarmInput
+
+		mockCWClient.EXPECT().PutMetricAlarm(gomock.Any()).DoAndReturn(
+			func(i *awscw.PutMetricAlarmInput) (*awscw.PutMetricAlarmOutput, error) {
+				input = i
+				return &awscw.PutMetricAlarmOutput{}, nil
+			},
+		)
+
+		alarm, _ := operation.alarm()
+		cw := CW.NewWithClient(mockCWClient)
+
+		if err := cw.PutMetricAlarm(alarm); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+
+		mockCtrl.Finish()
+
+		if aws.StringValue(input.Namespace) != test.namespace || aws.StringValue(input.MetricName) != test.metricName {
+			t.Errorf("Expected %s %s, got %s %s", test.namespace, test.metricName, aws.StringValue(input.Namespace), aws.StringValue(input.MetricName))
+		}
+
+		if aws.StringValue(input.Statistic) != test.statistic || aws.Int64Value(input.Period) != 300 || aws.Int64Value(input.EvaluationPeriods) != 2 {
+			t.Errorf("Expected %s over 300 x 2, got %s over %d x %d", test.statistic, aws.StringValue(input.Statistic), aws.Int64Value(input.Period), aws.Int64Value(input.EvaluationPeriods))
+		}
+
+		if aws.Float64Value(input.Threshold) != operation.Threshold || aws.StringValue(input.ComparisonOperator) != awscw.ComparisonOperatorGreaterThanOrEqualToThreshold {
+			t.Errorf("Expected >= %g, got %s %g", operation.Threshold, aws.StringValue(input.ComparisonOperator), aws.Float64Value(input.Threshold))
+		}
+
+		if aws.StringValue(input.TreatMissingData) != test.missing {
+			t.Errorf("Expected missing data treated as %q, got %q", test.missing, aws.StringValue(input.TreatMissingData))
+		}
+
+		var dimensions []string
+		for _, dimension := range input.Dimensions {
+			dimensions = append(dimensions, aws.StringValue(dimension.Name)+"="+aws.StringValue(dimension.Value))
+		}
+
+		if !reflect.DeepEqual(dimensions, test.dimensions) {
+			t.Errorf("Expected dimensions %v, got %v", test.dimensions, dimensions)
+		}
+
+		if actions := aws.StringValueSlice(input.AlarmActions); !reflect.DeepEqual(actions, operation.SNSTopicArns) {
+			t.Errorf("Expected alarm actions %v, got %v", operation.SNSTopicArns, actions)
+		}
+	}
+}
+
+func TestHasAlarm(t *testing.T) {
+	alarms := []CW.Alarm{{Name: "fargate/api/cpu"}, {Name: "fargate/api/errors"}}
+
+	if !hasAlarm(alarms, "fargate/api/errors") {
+		t.Error("Expected fargate/api/errors to be found")
+	}
+
+	if hasAlarm(alarms, "fargate/api/memory") {
+		t.Error("Expected fargate/api/memory not to be found")
+	}
+}
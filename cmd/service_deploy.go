@@ -1,11 +1,16 @@
 package cmd
 
 import (
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	CWL "github.com/turnerlabs/fargate/cloudwatchlogs"
 	"github.com/turnerlabs/fargate/console"
 	"github.com/turnerlabs/fargate/dockercompose"
 	ECS "github.com/turnerlabs/fargate/ecs"
-	"github.com/turnerlabs/fargate/sts"
 )
 
 // ServiceDeployOperation represents a deploy operation
@@ -16,6 +21,10 @@ type ServiceDeployOperation struct {
 	Region         string
 	Revision       string
 	WaitForService bool
+	FailedLogLines int
+
+	ecs ECS.ECS
+	cwl CWL.CloudWatchLogs
 }
 
 const deployDockerComposeLabel = "aws.ecs.fargate.deploy"
@@ -25,6 +34,7 @@ var flagServiceDeployDockerComposeFile string
 var flagServiceDeployDockerComposeImageOnly bool
 var flagServiceDeployRevision string
 var flagServiceDeployWaitForService bool
+var flagServiceDeployLogLines int
 
 var serviceDeployCmd = &cobra.Command{
 	Use:   "deploy",
@@ -42,11 +52,17 @@ A task definition revision can be specified via the --revision flag.
 The revision number can either be absolute or a delta specified with a sign
 such as +5 or -2, where -2 is "2 configurations ago" from the current
 deployed revision.
+
+With --wait-for-service, if the service does not reach a steady state running
+the deployed revision, the stop reason and the last log lines (20 unless
+--log-lines is passed) of each task of the deployed revision that stopped
+during the deployment are printed.
 `,
 	Example: `
 fargate service deploy -i 123456789.dkr.ecr.us-east-1.amazonaws.com/my-service:1.0
 fargate service deploy -f docker-compose.yml
 fargate service deploy -r 38
+fargate service deploy -i 123456789.dkr.ecr.us-east-1.amazonaws.com/my-service:1.1 -w --log-lines 50
 `,
 	Run: func(cmd *cobra.Command, args []string) {
 		operation := &ServiceDeployOperation{
@@ -56,6 +72,9 @@ fargate service deploy -r 38
 			ComposeFile:    flagServiceDeployDockerComposeFile,
 			Revision:       flagServiceDeployRevision,
 			WaitForService: flagServiceDeployWaitForService,
+			FailedLogLines: flagServiceDeployLogLines,
+			ecs:            ECS.New(sess, getClusterName()),
+			cwl:            CWL.New(sess),
 		}
 
 		if !validateFlags(operation) {
@@ -78,12 +97,26 @@ func init() {
 
 	serviceDeployCmd.Flags().BoolVarP(&flagServiceDeployWaitForService, "wait-for-service", "w", false, "Wait for the service to reach a steady state after deploying the new task definition.")
 
+	serviceDeployCmd.Flags().IntVar(&flagServiceDeployLogLines, "log-lines", defaultFailedTaskLogLines, "Number of log lines to show for each task that failed while waiting for the service.")
+
 	serviceCmd.AddCommand(serviceDeployCmd)
 }
 
+// describes the environment being deployed to with its resolved cluster and
+// region, which can come from the environment stanza of fargate.yml
+func formatDeployEnvironment(v *viper.Viper) string {
+	return fmt.Sprintf("Deploying to environment %s (cluster %s, region %s)", v.GetString(keyEnvironment), v.GetString(keyCluster), getRegionFrom(v))
+}
+
 func deployService(operation *ServiceDeployOperation) {
 	var taskDefinitionArn string
 
+	if getEnvironment() != "" {
+		console.Info("%s", formatDeployEnvironment(viper.GetViper()))
+	}
+
+	deployedAt := time.Now()
+
 	if operation.ComposeFile != "" {
 		taskDefinitionArn = deployDockerComposeFile(operation)
 	} else if operation.Revision != "" {
@@ -93,14 +126,23 @@ func deployService(operation *ServiceDeployOperation) {
 	}
 
 	if operation.WaitForService {
-		ecs := ECS.New(sess, getClusterName())
+		ecs := operation.ecs
 
 		console.Info("Waiting for service %s to reach a steady state...", operation.ServiceName)
-		ecs.WaitUntilServiceStable(operation.ServiceName)
+
+		if err := ecs.WaitForServiceStable(operation.ServiceName); err != nil {
+			showFailedDeployTasks(operation, deployedAt, taskDefinitionArn)
+			console.ErrorExit(err, "Could not wait for ECS service to reach a steady state")
+		}
 
 		//validate that the stable revision matches the deployed task
-		service := ecs.DescribeService(operation.ServiceName)
+		service, err := ecs.DescribeService(operation.ServiceName)
+		if err != nil {
+			console.ErrorExit(err, "Could not describe ECS service")
+		}
+
 		if service.TaskDefinitionArn != taskDefinitionArn {
+			showFailedDeployTasks(operation, deployedAt, taskDefinitionArn)
 			console.IssueExit("Stable revision %s does not match deployed revision %s", ecs.GetRevisionNumber(service.TaskDefinitionArn), ecs.GetRevisionNumber(taskDefinitionArn))
 		} else {
 			console.Info("Service %s has reached a steady state.", operation.ServiceName)
@@ -108,12 +150,22 @@ func deployService(operation *ServiceDeployOperation) {
 	}
 }
 
+// print the stop reasons and last log lines of the tasks of a deployed revision
+// that stopped since it was deployed
+func showFailedDeployTasks(operation *ServiceDeployOperation, deployedAt time.Time, taskDefinitionArn string) {
+	console.Info("Tasks of service %s that stopped during the deployment:", operation.ServiceName)
+	showFailedTasks(&GetLogsOperation{Tail: operation.FailedLogLines, ecs: &operation.ecs, cwl: operation.cwl}, operation.ServiceName, deployedAt, taskDefinitionArn)
+}
+
 // deploy a docker-compose.yml file to fargate
 func deployDockerComposeFile(operation *ServiceDeployOperation) string {
 	var taskDefinitionArn string
 
-	ecs := ECS.New(sess, getClusterName())
-	ecsService := ecs.DescribeService(operation.ServiceName)
+	ecs := operation.ecs
+	ecsService, err := ecs.DescribeService(operation.ServiceName)
+	if err != nil {
+		console.ErrorExit(err, "Could not describe ECS service")
+	}
 
 	dockerService := getDockerServiceFromComposeFile(operation.ComposeFile)
 
@@ -123,14 +175,22 @@ func deployDockerComposeFile(operation *ServiceDeployOperation) string {
 	//if --image-only flag is set, update image only
 	if flagServiceDeployDockerComposeImageOnly {
 		//register a new task definition based on the image from the compose file
-		taskDefinitionArn = ecs.UpdateTaskDefinitionImage(ecsService.TaskDefinitionArn, dockerService.Image)
+		taskDefinitionArn, err = ecs.UpdateTaskDefinitionImage(ecsService.TaskDefinitionArn, dockerService.Image)
+		if err != nil {
+			console.ErrorExit(err, "Could not register ECS task definition")
+		}
 	} else {
 		//register a new task definition based on the image and environment variables from the compose file
-		taskDefinitionArn = ecs.UpdateTaskDefinitionImageAndEnvVars(ecsService.TaskDefinitionArn, dockerService.Image, envvars, true, secrets)
+		taskDefinitionArn, err = ecs.UpdateTaskDefinitionImageAndEnvVars(ecsService.TaskDefinitionArn, dockerService.Image, envvars, true, secrets)
+		if err != nil {
+			console.ErrorExit(err, "Could not register ECS task definition")
+		}
 	}
 
 	//update service with new task definition
-	ecs.UpdateServiceTaskDefinition(operation.ServiceName, taskDefinitionArn)
+	if err := ecs.UpdateServiceTaskDefinition(operation.ServiceName, taskDefinitionArn); err != nil {
+		console.ErrorExit(err, "Could not update ECS service task definition")
+	}
 
 	if flagServiceDeployDockerComposeImageOnly {
 		console.Info("Deployed %s to service %s", dockerService.Image, operation.ServiceName)
@@ -142,13 +202,13 @@ func deployDockerComposeFile(operation *ServiceDeployOperation) string {
 }
 
 func deployRevision(operation *ServiceDeployOperation) string {
-	ecs := ECS.New(sess, getClusterName())
-	service := ecs.DescribeService(operation.ServiceName)
-
-	sts := sts.New(sess)
-	account := sts.GetCallerIdentity().Account
+	ecs := operation.ecs
+	service, err := ecs.DescribeService(operation.ServiceName)
+	if err != nil {
+		console.ErrorExit(err, "Could not describe ECS service")
+	}
 
-	//build full task definiton arn with revision
+	//resolve the full task definiton arn of the revision
 	revisionNumber := ecs.ResolveRevisionNumber(service.TaskDefinitionArn, operation.Revision)
 	taskFamily := ecs.GetTaskFamily(service.TaskDefinitionArn)
 
@@ -156,9 +216,16 @@ func deployRevision(operation *ServiceDeployOperation) string {
 		console.IssueExit("Could not resolve revision number")
 	}
 
-	taskDefinitionArn := ecs.GetTaskDefinitionARN(operation.Region, account, taskFamily, revisionNumber)
+	revision, err := ecs.DescribeTaskDefinition(taskFamily + ":" + revisionNumber)
+	if err != nil {
+		console.ErrorExit(err, "Could not describe ECS task definition")
+	}
+
+	taskDefinitionArn := aws.StringValue(revision.TaskDefinition.TaskDefinitionArn)
 
-	ecs.UpdateServiceTaskDefinition(operation.ServiceName, taskDefinitionArn)
+	if err := ecs.UpdateServiceTaskDefinition(operation.ServiceName, taskDefinitionArn); err != nil {
+		console.ErrorExit(err, "Could not update ECS service task definition")
+	}
 
 	console.Info("Deployed revision %s to service %s.", revisionNumber, operation.ServiceName)
 
@@ -166,11 +233,20 @@ func deployRevision(operation *ServiceDeployOperation) string {
 }
 
 func deployImage(operation *ServiceDeployOperation) string {
-	ecs := ECS.New(sess, getClusterName())
-	service := ecs.DescribeService(operation.ServiceName)
-	taskDefinitionArn := ecs.UpdateTaskDefinitionImage(service.TaskDefinitionArn, operation.Image)
+	ecs := operation.ecs
+	service, err := ecs.DescribeService(operation.ServiceName)
+	if err != nil {
+		console.ErrorExit(err, "Could not describe ECS service")
+	}
 
-	ecs.UpdateServiceTaskDefinition(operation.ServiceName, taskDefinitionArn)
+	taskDefinitionArn, err := ecs.UpdateTaskDefinitionImage(service.TaskDefinitionArn, operation.Image)
+	if err != nil {
+		console.ErrorExit(err, "Could not register ECS task definition")
+	}
+
+	if err := ecs.UpdateServiceTaskDefinition(operation.ServiceName, taskDefinitionArn); err != nil {
+		console.ErrorExit(err, "Could not update ECS service task definition")
+	}
 
 	console.Info("Deployed %s to service %s", operation.Image, operation.ServiceName)
 
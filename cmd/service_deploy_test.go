@@ -3,8 +3,13 @@ package cmd
 import (
 	"testing"
 
+	"github.com/aws/aws-sdk-go/aws"
+	awsecs "github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/golang/mock/gomock"
 	"github.com/turnerlabs/fargate/console"
 	"github.com/turnerlabs/fargate/dockercompose"
+	ECS "github.com/turnerlabs/fargate/ecs"
+	"github.com/turnerlabs/fargate/ecs/mock/sdk"
 )
 
 func TestGetDockerServiceToDeploy_Happy(t *testing.T) {
@@ -177,3 +182,174 @@ services:
 		t.Errorf("expected: %s, got: %s", expected, got)
 	}
 }
+
+// expects a service to be described, running a task definition with the given
+// containers. Task definitions are cached by the ecs package, so each test
+// should use its own task definition arns.
+func expectDescribeService(mockECSClient *sdk.MockECSAPI, serviceName, taskDefinitionArn string, containers ...*awsecs.ContainerDefinition) {
+	mockECSClient.EXPECT().DescribeServices(
+		&awsecs.DescribeServicesInput{
+			Cluster:  aws.String("my-cluster"),
+			Services: aws.StringSlice([]string{serviceName}),
+		},
+	).Return(
+		&awsecs.DescribeServicesOutput{
+			Services: []*awsecs.Service{
+				&awsecs.Service{
+					ServiceName:          aws.String(serviceName),
+					Status:               aws.String("ACTIVE"),
+					TaskDefinition:       aws.String(taskDefinitionArn),
+					NetworkConfiguration: &awsecs.NetworkConfiguration{},
+				},
+			},
+		},
+		nil,
+	)
+
+	expectDescribeTaskDefinition(mockECSClient, taskDefinitionArn, containers...)
+}
+
+func expectDescribeTaskDefinition(mockECSClient *sdk.MockECSAPI, taskDefinitionArn string, containers ...*awsecs.ContainerDefinition) {
+	mockECSClient.EXPECT().DescribeTaskDefinition(
+		&awsecs.DescribeTaskDefinitionInput{
+			TaskDefinition: aws.String(taskDefinitionArn),
+			Include:        aws.StringSlice([]string{"TAGS"}),
+		},
+	).Return(
+		&awsecs.DescribeTaskDefinitionOutput{
+			TaskDefinition: &awsecs.TaskDefinition{
+				TaskDefinitionArn:    aws.String(taskDefinitionArn),
+				ContainerDefinitions: containers,
+			},
+		},
+		nil,
+	).AnyTimes()
+}
+
+// expects a task definition to be registered, and returns the registered
+// container definitions through the given pointer
+func expectRegisterTaskDefinition(mockECSClient *sdk.MockECSAPI, taskDefinitionArn string, registered *[]*awsecs.ContainerDefinition) {
+	mockECSClient.EXPECT().RegisterTaskDefinition(gomock.Any()).DoAndReturn(
+		func(input *awsecs.RegisterTaskDefinitionInput) (*awsecs.RegisterTaskDefinitionOutput, error) {
+			*registered = input.ContainerDefinitions
+
+			return &awsecs.RegisterTaskDefinitionOutput{
+				TaskDefinition: &awsecs.TaskDefinition{TaskDefinitionArn: aws.String(taskDefinitionArn)},
+			}, nil
+		},
+	)
+}
+
+func expectUpdateServiceTaskDefinition(mockECSClient *sdk.MockECSAPI, serviceName, taskDefinitionArn string) {
+	mockECSClient.EXPECT().UpdateService(
+		&awsecs.UpdateServiceInput{
+			Cluster:        aws.String("my-cluster"),
+			Service:        aws.String(serviceName),
+			TaskDefinition: aws.String(taskDefinitionArn),
+		},
+	).Return(&awsecs.UpdateServiceOutput{}, nil)
+}
+
+func TestDeployServiceImage(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	currentArn := "arn:aws:ecs:us-east-1:123456789012:task-definition/deploy-image:1"
+	deployedArn := "arn:aws:ecs:us-east-1:123456789012:task-definition/deploy-image:2"
+	image := "123456789.dkr.ecr.us-east-1.amazonaws.com/web:1.1"
+
+	var registered []*awsecs.ContainerDefinition
+
+	mockECSClient := sdk.NewMockECSAPI(mockCtrl)
+	expectDescribeService(mockECSClient, "web", currentArn, &awsecs.ContainerDefinition{Name: aws.String("web"), Image: aws.String("web:1.0")})
+	expectRegisterTaskDefinition(mockECSClient, deployedArn, &registered)
+	expectUpdateServiceTaskDefinition(mockECSClient, "web", deployedArn)
+
+	deployService(&ServiceDeployOperation{
+		ServiceName: "web",
+		Image:       image,
+		ecs:         ECS.NewWithClient(mockECSClient, "my-cluster"),
+	})
+
+	if len(registered) != 1 || aws.StringValue(registered[0].Image) != image {
+		t.Errorf("Expected image %s to be registered, got %v", image, registered)
+	}
+}
+
+func TestDeployServiceRevision(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	currentArn := "arn:aws:ecs:us-east-1:123456789012:task-definition/deploy-revision:5"
+	revisionArn := "arn:aws:ecs:us-east-1:123456789012:task-definition/deploy-revision:3"
+
+	mockECSClient := sdk.NewMockECSAPI(mockCtrl)
+	expectDescribeService(mockECSClient, "web", currentArn, &awsecs.ContainerDefinition{Name: aws.String("web"), Image: aws.String("web:1.0")})
+	mockECSClient.EXPECT().DescribeTaskDefinition(
+		&awsecs.DescribeTaskDefinitionInput{
+			TaskDefinition: aws.String("deploy-revision:3"),
+			Include:        aws.StringSlice([]string{"TAGS"}),
+		},
+	).Return(
+		&awsecs.DescribeTaskDefinitionOutput{
+			TaskDefinition: &awsecs.TaskDefinition{TaskDefinitionArn: aws.String(revisionArn)},
+		},
+		nil,
+	).AnyTimes()
+	expectUpdateServiceTaskDefinition(mockECSClient, "web", revisionArn)
+
+	deployService(&ServiceDeployOperation{
+		ServiceName: "web",
+		Revision:    "-2",
+		ecs:         ECS.NewWithClient(mockECSClient, "my-cluster"),
+	})
+}
+
+func TestDeployServiceWaitForService(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	currentArn := "arn:aws:ecs:us-east-1:123456789012:task-definition/deploy-wait:1"
+	deployedArn := "arn:aws:ecs:us-east-1:123456789012:task-definition/deploy-wait:2"
+
+	var registered []*awsecs.ContainerDefinition
+
+	mockECSClient := sdk.NewMockECSAPI(mockCtrl)
+	expectDescribeService(mockECSClient, "web", currentArn, &awsecs.ContainerDefinition{Name: aws.String("web"), Image: aws.String("web:1.0")})
+	expectRegisterTaskDefinition(mockECSClient, deployedArn, &registered)
+	expectUpdateServiceTaskDefinition(mockECSClient, "web", deployedArn)
+	mockECSClient.EXPECT().WaitUntilServicesStable(gomock.Any()).Return(nil)
+	expectDescribeService(mockECSClient, "web", deployedArn, &awsecs.ContainerDefinition{Name: aws.String("web"), Image: aws.String("web:1.1")})
+
+	deployService(&ServiceDeployOperation{
+		ServiceName:    "web",
+		Image:          "web:1.1",
+		WaitForService: true,
+		ecs:            ECS.NewWithClient(mockECSClient, "my-cluster"),
+	})
+}
+
+func TestFormatDeployEnvironment(t *testing.T) {
+	t.Setenv("AWS_REGION", "")
+	t.Setenv("AWS_DEFAULT_REGION", "")
+
+	//the region is only set in the environment stanza
+	v := readTestConfig(t, `
+cluster: dev
+environments:
+  prod:
+    cluster: prod
+    region: us-west-2
+`)
+	v.Set(keyEnvironment, "prod")
+
+	if err := applyEnvironment(v, "prod"); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := "Deploying to environment prod (cluster prod, region us-west-2)"
+
+	if got := formatDeployEnvironment(v); got != expected {
+		t.Errorf("Expected %s, got %s", expected, got)
+	}
+}
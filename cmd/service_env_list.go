@@ -2,12 +2,15 @@ package cmd
 
 import (
 	"fmt"
+	"github.com/turnerlabs/fargate/console"
 	ECS "github.com/turnerlabs/fargate/ecs"
 	"github.com/spf13/cobra"
 )
 
 type ServiceEnvListOperation struct {
 	ServiceName string
+
+	ecs ECS.ECS
 }
 
 var serviceEnvListCmd = &cobra.Command{
@@ -16,6 +19,7 @@ var serviceEnvListCmd = &cobra.Command{
 	Run: func(cmd *cobra.Command, args []string) {
 		operation := &ServiceEnvListOperation{
 			ServiceName: getServiceName(),
+			ecs:         ECS.New(sess, getClusterName()),
 		}
 
 		serviceEnvList(operation)
@@ -27,9 +31,16 @@ func init() {
 }
 
 func serviceEnvList(operation *ServiceEnvListOperation) {
-	ecs := ECS.New(sess, getClusterName())
-	service := ecs.DescribeService(operation.ServiceName)
-	envVars := ecs.GetEnvVarsFromTaskDefinition(service.TaskDefinitionArn)
+	ecs := operation.ecs
+	service, err := ecs.DescribeService(operation.ServiceName)
+	if err != nil {
+		console.ErrorExit(err, "Could not describe ECS service")
+	}
+
+	envVars, err := ecs.GetEnvVarsFromTaskDefinition(service.TaskDefinitionArn)
+	if err != nil {
+		console.ErrorExit(err, "Could not describe ECS task definition")
+	}
 
 	ecs.SortEnvVars(envVars)
 
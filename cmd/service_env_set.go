@@ -10,6 +10,8 @@ type ServiceEnvSetOperation struct {
 	ServiceName string
 	EnvVars     []ECS.EnvVar
 	SecretVars  []ECS.Secret
+
+	ecs ECS.ECS
 }
 
 func (o *ServiceEnvSetOperation) Validate() {
@@ -73,6 +75,7 @@ underscore and consist of only letters, digits, and underscores.`,
 	Run: func(cmd *cobra.Command, args []string) {
 		operation := &ServiceEnvSetOperation{
 			ServiceName: getServiceName(),
+			ecs:         ECS.New(sess, getClusterName()),
 		}
 
 		operation.SetEnvVars(flagServiceEnvSetEnvVars, flagServiceEnvSetEnvFile)
@@ -92,11 +95,20 @@ func init() {
 }
 
 func serviceEnvSet(operation *ServiceEnvSetOperation) {
-	ecs := ECS.New(sess, getClusterName())
-	service := ecs.DescribeService(operation.ServiceName)
-	taskDefinitionArn := ecs.AddEnvVarsToTaskDefinition(service.TaskDefinitionArn, operation.EnvVars, operation.SecretVars)
+	ecs := operation.ecs
+	service, err := ecs.DescribeService(operation.ServiceName)
+	if err != nil {
+		console.ErrorExit(err, "Could not describe ECS service")
+	}
 
-	ecs.UpdateServiceTaskDefinition(operation.ServiceName, taskDefinitionArn)
+	taskDefinitionArn, err := ecs.AddEnvVarsToTaskDefinition(service.TaskDefinitionArn, operation.EnvVars, operation.SecretVars)
+	if err != nil {
+		console.ErrorExit(err, "Could not register ECS task definition")
+	}
+
+	if err := ecs.UpdateServiceTaskDefinition(operation.ServiceName, taskDefinitionArn); err != nil {
+		console.ErrorExit(err, "Could not update ECS service task definition")
+	}
 
 	if len(operation.EnvVars) > 0 {
 		console.Info("Set %s environment variables:", operation.ServiceName)
@@ -0,0 +1,93 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awsecs "github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/golang/mock/gomock"
+	ECS "github.com/turnerlabs/fargate/ecs"
+	"github.com/turnerlabs/fargate/ecs/mock/sdk"
+)
+
+func TestServiceEnvSet(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	currentArn := "arn:aws:ecs:us-east-1:123456789012:task-definition/env-set:1"
+	updatedArn := "arn:aws:ecs:us-east-1:123456789012:task-definition/env-set:2"
+
+	var registered []*awsecs.ContainerDefinition
+
+	mockECSClient := sdk.NewMockECSAPI(mockCtrl)
+	expectDescribeService(mockECSClient, "web", currentArn,
+		&awsecs.ContainerDefinition{
+			Name:  aws.String("web"),
+			Image: aws.String("web:1.0"),
+			Environment: []*awsecs.KeyValuePair{
+				&awsecs.KeyValuePair{Name: aws.String("PORT"), Value: aws.String("80")},
+				&awsecs.KeyValuePair{Name: aws.String("LOG_LEVEL"), Value: aws.String("info")},
+			},
+		},
+	)
+	expectRegisterTaskDefinition(mockECSClient, updatedArn, &registered)
+	expectUpdateServiceTaskDefinition(mockECSClient, "web", updatedArn)
+
+	serviceEnvSet(&ServiceEnvSetOperation{
+		ServiceName: "web",
+		EnvVars:     []ECS.EnvVar{ECS.EnvVar{Key: "LOG_LEVEL", Value: "debug"}},
+		SecretVars:  []ECS.Secret{ECS.Secret{Key: "DB_PASSWORD", ValueFrom: "arn:aws:ssm:us-east-1:123456789012:parameter/db"}},
+		ecs:         ECS.NewWithClient(mockECSClient, "my-cluster"),
+	})
+
+	if len(registered) != 1 {
+		t.Fatalf("Expected 1 container definition, got %d", len(registered))
+	}
+
+	environment := map[string]string{}
+	for _, kv := range registered[0].Environment {
+		environment[aws.StringValue(kv.Name)] = aws.StringValue(kv.Value)
+	}
+
+	if len(environment) != 2 || environment["PORT"] != "80" || environment["LOG_LEVEL"] != "debug" {
+		t.Errorf("Unexpected environment %v", environment)
+	}
+
+	if len(registered[0].Secrets) != 1 || aws.StringValue(registered[0].Secrets[0].Name) != "DB_PASSWORD" {
+		t.Errorf("Unexpected secrets %v", registered[0].Secrets)
+	}
+}
+
+func TestServiceEnvUnset(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	currentArn := "arn:aws:ecs:us-east-1:123456789012:task-definition/env-unset:1"
+	updatedArn := "arn:aws:ecs:us-east-1:123456789012:task-definition/env-unset:2"
+
+	var registered []*awsecs.ContainerDefinition
+
+	mockECSClient := sdk.NewMockECSAPI(mockCtrl)
+	expectDescribeService(mockECSClient, "web", currentArn,
+		&awsecs.ContainerDefinition{
+			Name:  aws.String("web"),
+			Image: aws.String("web:1.0"),
+			Environment: []*awsecs.KeyValuePair{
+				&awsecs.KeyValuePair{Name: aws.String("PORT"), Value: aws.String("80")},
+				&awsecs.KeyValuePair{Name: aws.String("LOG_LEVEL"), Value: aws.String("info")},
+			},
+		},
+	)
+	expectRegisterTaskDefinition(mockECSClient, updatedArn, &registered)
+	expectUpdateServiceTaskDefinition(mockECSClient, "web", updatedArn)
+
+	serviceEnvUnset(&ServiceEnvUnsetOperation{
+		ServiceName: "web",
+		Keys:        []string{"LOG_LEVEL"},
+		ecs:         ECS.NewWithClient(mockECSClient, "my-cluster"),
+	})
+
+	if len(registered) != 1 || len(registered[0].Environment) != 1 || aws.StringValue(registered[0].Environment[0].Name) != "PORT" {
+		t.Errorf("Unexpected container definitions %v", registered)
+	}
+}
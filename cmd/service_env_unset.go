@@ -9,6 +9,8 @@ import (
 type ServiceEnvUnsetOperation struct {
 	ServiceName string
 	Keys        []string
+
+	ecs ECS.ECS
 }
 
 func (o *ServiceEnvUnsetOperation) Validate() {
@@ -27,6 +29,7 @@ a key name multiple times to unset multiple variables.`,
 	Run: func(cmd *cobra.Command, args []string) {
 		operation := &ServiceEnvUnsetOperation{
 			ServiceName: getServiceName(),
+			ecs:         ECS.New(sess, getClusterName()),
 		}
 
 		operation.Keys = flagServiceEnvUnsetKeys
@@ -44,11 +47,20 @@ func init() {
 }
 
 func serviceEnvUnset(operation *ServiceEnvUnsetOperation) {
-	ecs := ECS.New(sess, getClusterName())
-	service := ecs.DescribeService(operation.ServiceName)
-	taskDefinitionArn := ecs.RemoveEnvVarsFromTaskDefinition(service.TaskDefinitionArn, operation.Keys)
+	ecs := operation.ecs
+	service, err := ecs.DescribeService(operation.ServiceName)
+	if err != nil {
+		console.ErrorExit(err, "Could not describe ECS service")
+	}
 
-	ecs.UpdateServiceTaskDefinition(operation.ServiceName, taskDefinitionArn)
+	taskDefinitionArn, err := ecs.RemoveEnvVarsFromTaskDefinition(service.TaskDefinitionArn, operation.Keys)
+	if err != nil {
+		console.ErrorExit(err, "Could not register ECS task definition")
+	}
+
+	if err := ecs.UpdateServiceTaskDefinition(operation.ServiceName, taskDefinitionArn); err != nil {
+		console.ErrorExit(err, "Could not update ECS service task definition")
+	}
 
 	console.Info("Unset %s environment variables:", operation.ServiceName)
 
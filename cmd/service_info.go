@@ -55,14 +55,26 @@ func getServiceInfo(operation *ServiceInfoOperation) {
 	ec2 := EC2.New(sess)
 	elbv2 := ELBV2.New(sess)
 	sd := SD.New(sess)
-	service := ecs.DescribeService(operation.ServiceName)
-	tasks := ecs.DescribeTasksForService(operation.ServiceName)
+	service, err := ecs.DescribeService(operation.ServiceName)
+	if err != nil {
+		console.ErrorExit(err, "Could not describe ECS service")
+	}
+
+	tasks, err := ecs.DescribeTasksForService(operation.ServiceName)
+	if err != nil {
+		console.ErrorExit(err, "Could not list ECS tasks")
+	}
 
 	if service.Status != statusActive {
 		console.InfoExit("Service not found")
 	}
 
 	console.KeyValue("Service Name", "%s\n", operation.ServiceName)
+
+	if environment := getEnvironment(); environment != "" {
+		console.KeyValue("Environment", "%s\n", environment)
+	}
+
 	console.KeyValue("Status", "\n")
 	console.KeyValue("  Desired", "%d\n", service.DesiredCount)
 	console.KeyValue("  Running", "%d\n", service.RunningCount)
@@ -79,9 +91,21 @@ func getServiceInfo(operation *ServiceInfoOperation) {
 	console.KeyValue("Security Groups", "%s\n", strings.Join(service.SecurityGroupIds, ", "))
 
 	if service.TargetGroupArn != "" {
-		if loadBalancerArn := elbv2.GetTargetGroupLoadBalancerArn(service.TargetGroupArn); loadBalancerArn != "" {
-			loadBalancer := elbv2.DescribeLoadBalancerByARN(loadBalancerArn)
-			listeners := elbv2.GetListeners(loadBalancerArn)
+		loadBalancerArn, err := elbv2.GetTargetGroupLoadBalancerArn(service.TargetGroupArn)
+		if err != nil {
+			console.ErrorExit(err, "Could not describe ELB target groups")
+		}
+
+		if loadBalancerArn != "" {
+			loadBalancer, err := elbv2.DescribeLoadBalancerByARN(loadBalancerArn)
+			if err != nil {
+				console.ErrorExit(err, "Could not find ELB load balancer")
+			}
+
+			listeners, err := elbv2.GetListeners(loadBalancerArn)
+			if err != nil {
+				console.ErrorExit(err, "Could not retrieve ELB listeners")
+			}
 
 			console.KeyValue("Load Balancer", "\n")
 			console.KeyValue("  Name", "%s\n", loadBalancer.Name)
@@ -94,7 +118,10 @@ func getServiceInfo(operation *ServiceInfoOperation) {
 			for _, listener := range listeners {
 				var ruleOutput []string
 
-				rules := elbv2.DescribeRules(listener.ARN)
+				rules, err := elbv2.DescribeRules(listener.ARN)
+				if err != nil {
+					console.ErrorExit(err, "Could not describe ELB rules")
+				}
 
 				sort.Slice(rules, func(i, j int) bool { return rules[i].Priority > rules[j].Priority })
 
@@ -143,7 +170,11 @@ func getServiceInfo(operation *ServiceInfoOperation) {
 		fmt.Fprintln(w, "ID\tENDPOINT\tNAMESPACE\tTYPE\tPORT\tTTL\t")
 
 		for _, reg := range service.ServiceRegistries {
-			srv := sd.GetService(reg.RegistryArn)
+			srv, err := sd.GetService(reg.RegistryArn)
+			if err != nil {
+				console.ErrorExit(err, "Could not describe ServiceDiscovery service")
+			}
+
 			ns := new(strings.Builder)
 
 			ns.WriteString(srv.Namespace.Name)
@@ -184,7 +215,11 @@ func getServiceInfo(operation *ServiceInfoOperation) {
 			}
 		}
 
-		enis := ec2.DescribeNetworkInterfaces(eniIds)
+		enis, err := ec2.DescribeNetworkInterfaces(eniIds)
+		if err != nil {
+			console.ErrorExit(err, "Could not describe network interfaces")
+		}
+
 		w := new(tabwriter.Writer)
 
 		w.Init(os.Stdout, 0, 8, 1, '\t', 0)
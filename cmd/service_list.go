@@ -32,7 +32,10 @@ func listServices() {
 
 	ecs := ECS.New(sess, getClusterName())
 	elbv2 := ELBV2.New(sess)
-	services := ecs.ListServices()
+	services, err := ecs.ListServices()
+	if err != nil {
+		console.ErrorExit(err, "Could not list ECS services")
+	}
 
 	for _, service := range services {
 		if service.TargetGroupArn != "" {
@@ -41,7 +44,12 @@ func listServices() {
 	}
 
 	if len(targetGroupArns) > 0 {
-		for _, targetGroup := range elbv2.DescribeTargetGroups(targetGroupArns) {
+		describedTargetGroups, err := elbv2.DescribeTargetGroups(targetGroupArns)
+		if err != nil {
+			console.ErrorExit(err, "Could not describe ELB target groups")
+		}
+
+		for _, targetGroup := range describedTargetGroups {
 			targetGroups[targetGroup.Arn] = targetGroup
 
 			if targetGroup.LoadBalancerARN != "" {
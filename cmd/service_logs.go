@@ -1,9 +1,11 @@
 package cmd
 
 import (
-	"fmt"
+	"time"
 
 	"github.com/spf13/cobra"
+	CWL "github.com/turnerlabs/fargate/cloudwatchlogs"
+	"github.com/turnerlabs/fargate/console"
 )
 
 var (
@@ -14,6 +16,18 @@ var (
 	flagServiceLogsTasks             []string
 	flagServiceLogsTime              bool
 	flagServiceLogsNoLogStreamPrefix bool
+	flagServiceLogsJSONFields        []string
+	flagServiceLogsLevel             string
+	flagServiceLogsOutput            string
+	flagServiceLogsSinceLast         bool
+	flagServiceLogsGrep              string
+	flagServiceLogsBeforeContext     int
+	flagServiceLogsAfterContext      int
+	flagServiceLogsInvert            bool
+	flagServiceLogsContainers        []string
+	flagServiceLogsAllContainers     bool
+	flagServiceLogsFailed            bool
+	flagServiceLogsLines             int
 )
 
 var serviceLogsCmd = &cobra.Command{
@@ -21,13 +35,29 @@ var serviceLogsCmd = &cobra.Command{
 	Short: "Show logs from tasks in a service",
 	Long: `Show logs from tasks in a service
 
+The log group and log stream prefix are read from the awslogs-group and
+awslogs-stream-prefix options of the log configuration of the service's task
+definition. If they are not set, the log group /fargate/service/<service-name>
+and the stream prefix fargate are assumed.
+
 Return either a specific segment of service logs or tail logs in real-time
 using the --follow option. Logs are prefixed by their log stream name which is
-in the format of "fargate/\<service-name>/\<task-id>."
+in the format of "<stream-prefix>/\<container-name>/\<task-id>."
+
+Logs are read from the first container of the task definition. Pass --container
+with one or more container names, or --all-containers, to merge the logs of
+several containers in time order. Merged logs are prefixed by their container
+name.
 
 Follow will continue to run and return logs until interrupted by Control-C. If
 --follow is passed --end cannot be specified.
 
+Follow keeps track of its position in each log stream, polling idle streams
+less often and backing off when throttled, and picks up the log streams of
+newly started tasks, which are listed in ECS (or found by listing the log
+streams if no cluster is configured). The position is saved, so that after a
+disconnect --since-last resumes following where the last --follow stopped.
+
 Logs can be returned for specific tasks within a service by passing a task ID
 via the --task flag. Pass --task with a task ID multiple times in order to
 retrieve logs from multiple specific tasks.
@@ -45,25 +75,68 @@ You can filter logs for specific term by passing a filter expression via the
 --filter flag. Pass a single term to search for that term, pass multiple terms
 to search for log messages that include all terms.
 
+--grep only shows messages matching a regular expression, which is applied
+client-side (after --filter) in both one-shot and follow modes. Matches are
+highlighted. -B/--before-context and -A/--after-context also show that many
+lines of the same log stream before and after each match, and --invert shows
+the messages that do not match instead.
+
 --time includes the log timestamp in the output
 
 --no-prefix excludes the log stream prefix from the output
+
+Messages logged as JSON objects can be rendered as selected fields with
+--json-fields (e.g. --json-fields level,msg,trace_id). Nested fields can be
+selected with a dot separated path (e.g. http.status). The severity is
+colorized when its field (e.g. level) is one of the selected fields.
+
+--level only shows structured messages with a severity (read from the level,
+severity, lvl, loglevel or log.level field), either an exact severity (e.g.
+warn) or a severity and everything above it (e.g. error+).
+
+--output ndjson prints each event as a JSON object with its timestamp, log
+stream, task ID and message (parsed if it is JSON), for piping into tools such
+as jq.
+
+--failed shows the tasks of the service that stopped since --start (default
+-1h) because a container exited with an error, a task failed to start, or a
+health check failed. The stop reason, exit codes and the last log lines (20
+unless --lines is passed) of each task are printed. ECS only keeps stopped
+tasks for about an hour.
+`,
+	Example: `
+fargate service logs --follow
+fargate service logs --start -1h --filter error
+fargate service logs --failed --lines 50
 `,
 	PreRun: func(cmd *cobra.Command, args []string) {
 	},
 	Run: func(cmd *cobra.Command, args []string) {
+		if flagServiceLogsFailed {
+			showFailedServiceTasks()
+			return
+		}
+
 		operation := &GetLogsOperation{
-			LogGroupName:      fmt.Sprintf(serviceLogGroupFormat, getServiceName()),
 			Filter:            flagServiceLogsFilter,
-			Follow:            flagServiceLogsFollow,
-			Namespace:         getServiceName(),
+			Follow:            flagServiceLogsFollow || flagServiceLogsSinceLast,
+			SinceLast:         flagServiceLogsSinceLast,
+			SaveCheckpoint:    true,
 			IncludeTime:       flagServiceLogsTime,
 			NoLogStreamPrefix: flagServiceLogsNoLogStreamPrefix,
+			JSONFields:        flagServiceLogsJSONFields,
+			Output:            flagServiceLogsOutput,
+			cwl:               CWL.New(sess),
 		}
 
+		operation.ValidateContainers(flagServiceLogsContainers, flagServiceLogsAllContainers)
+		operation.AddServiceLogSources(getServiceName(), flagServiceLogsContainers, flagServiceLogsAllContainers, false)
 		operation.AddTasks(flagServiceLogsTasks)
 		operation.AddStartTime(flagServiceLogsStartTime)
 		operation.AddEndTime(flagServiceLogsEndTime)
+		operation.AddLevelFilter(flagServiceLogsLevel)
+		operation.AddGrep(flagServiceLogsGrep, flagServiceLogsBeforeContext, flagServiceLogsAfterContext, flagServiceLogsInvert)
+		operation.Validate()
 
 		GetLogs(operation)
 	},
@@ -79,4 +152,44 @@ func init() {
 	serviceLogsCmd.Flags().StringSliceVarP(&flagServiceLogsTasks, "task", "t", []string{}, "Show logs from specific task (can be specified multiple times)")
 	serviceLogsCmd.PersistentFlags().BoolVarP(&flagServiceLogsTime, "time", "T", false, "append time to logs")
 	serviceLogsCmd.PersistentFlags().BoolVarP(&flagServiceLogsNoLogStreamPrefix, "no-prefix", "", false, "don't include log stream prefix in output")
+	serviceLogsCmd.Flags().StringSliceVar(&flagServiceLogsJSONFields, "json-fields", []string{}, "Render JSON messages as the given fields (e.g. level,msg,trace_id)")
+	serviceLogsCmd.Flags().StringVar(&flagServiceLogsLevel, "level", "", "Only show JSON messages with this severity, or above with + (e.g. error+)")
+	serviceLogsCmd.Flags().StringVar(&flagServiceLogsOutput, "output", logOutputText, "Output format (text or ndjson)")
+	serviceLogsCmd.Flags().BoolVar(&flagServiceLogsSinceLast, "since-last", false, "Follow logs from where the last --follow of the same logs stopped")
+	serviceLogsCmd.Flags().StringVar(&flagServiceLogsGrep, "grep", "", "Only show messages matching a regular expression")
+	serviceLogsCmd.Flags().IntVarP(&flagServiceLogsBeforeContext, "before-context", "B", 0, "Show lines of the same stream before each --grep match")
+	serviceLogsCmd.Flags().IntVarP(&flagServiceLogsAfterContext, "after-context", "A", 0, "Show lines of the same stream after each --grep match")
+	serviceLogsCmd.Flags().BoolVar(&flagServiceLogsInvert, "invert", false, "Only show messages not matching --grep")
+	serviceLogsCmd.Flags().StringSliceVar(&flagServiceLogsContainers, "container", []string{}, "Show logs from specific containers (e.g. app,nginx)")
+	serviceLogsCmd.Flags().BoolVar(&flagServiceLogsAllContainers, "all-containers", false, "Show logs from all containers of the task definition")
+	serviceLogsCmd.Flags().BoolVar(&flagServiceLogsFailed, "failed", false, "Show the stop reasons and last log lines of tasks that failed")
+	serviceLogsCmd.Flags().IntVar(&flagServiceLogsLines, "lines", defaultFailedTaskLogLines, "Number of log lines to show for each failed task")
+}
+
+func showFailedServiceTasks() {
+	if flagServiceLogsFollow || flagServiceLogsSinceLast {
+		console.IssueExit("--failed cannot be used with --follow or --since-last")
+	}
+
+	operation := &GetLogsOperation{
+		Filter:            flagServiceLogsFilter,
+		IncludeTime:       flagServiceLogsTime,
+		NoLogStreamPrefix: flagServiceLogsNoLogStreamPrefix,
+		JSONFields:        flagServiceLogsJSONFields,
+		Output:            flagServiceLogsOutput,
+		Tail:              flagServiceLogsLines,
+		cwl:               CWL.New(sess),
+	}
+
+	operation.AddStartTime(flagServiceLogsStartTime)
+	operation.AddLevelFilter(flagServiceLogsLevel)
+	operation.AddGrep(flagServiceLogsGrep, flagServiceLogsBeforeContext, flagServiceLogsAfterContext, flagServiceLogsInvert)
+	operation.Validate()
+
+	since := operation.StartTime
+	if since.IsZero() {
+		since = time.Now().Add(-time.Hour)
+	}
+
+	showFailedTasks(operation, getServiceName(), since, "")
 }
@@ -0,0 +1,75 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+var (
+	flagServiceLogsExportStartTime   string
+	flagServiceLogsExportEndTime     string
+	flagServiceLogsExportOut         string
+	flagServiceLogsExportTasks       []string
+	flagServiceLogsExportGzip        bool
+	flagServiceLogsExportConcurrency int
+	flagServiceLogsExportRestart     bool
+)
+
+var serviceLogsExportCmd = &cobra.Command{
+	Use:   "export --start <time-expression> --out <directory>",
+	Short: "Export service logs to files",
+	Long: `Export service logs to files
+
+Exports the logs of the tasks of a service within a time range to a directory,
+one file per task and container (<task-id>-<container-name>.log, or
+<task-id>-<container-name>.log.gz with --gzip). Each line contains the timestamp
+of the event followed by the message.
+
+Log streams are fetched concurrently (--concurrency) and requests that are
+throttled by CloudWatch Logs are retried with exponential backoff.
+
+The progress of the export is recorded in the output directory. If an export is
+interrupted or some log streams fail, running the command again with the same
+--out resumes where it stopped, using the time range of the original export.
+Pass --restart to discard the progress and start over.
+
+--start and --end accept the same time expressions as fargate service logs.
+--end defaults to now.`,
+	Example: `
+fargate service logs export --start -24h --out logs/
+fargate service logs export --start "2018-01-01 00:00:00 EST" --end "2018-01-02 00:00:00 EST" --out logs/ --gzip
+fargate service logs export --start -1h --task 0123456789abcdef --out logs/
+`,
+	Run: func(cmd *cobra.Command, args []string) {
+		logConfiguration := getServiceLogConfiguration(getServiceName())
+
+		operation := &LogsExportOperation{
+			LogGroupName:    logConfiguration.LogGroupName,
+			LogStreamPrefix: logConfiguration.LogStreamPrefix(),
+			TaskIds:         flagServiceLogsExportTasks,
+			OutputDirectory: flagServiceLogsExportOut,
+			Gzip:            flagServiceLogsExportGzip,
+			Concurrency:     flagServiceLogsExportConcurrency,
+			Restart:         flagServiceLogsExportRestart,
+		}
+
+		operation.SetTimeRange(flagServiceLogsExportStartTime, flagServiceLogsExportEndTime)
+		operation.Validate()
+
+		exportLogs(operation)
+	},
+}
+
+func init() {
+	serviceLogsExportCmd.Flags().StringVar(&flagServiceLogsExportStartTime, "start", "", "Earliest time to export logs from (e.g. -24h, 2018-01-01 09:36:00 EST")
+	serviceLogsExportCmd.Flags().StringVar(&flagServiceLogsExportEndTime, "end", "", "Latest time to export logs from (defaults to now)")
+	serviceLogsExportCmd.Flags().StringVarP(&flagServiceLogsExportOut, "out", "o", "", "Directory to write the log files to")
+	serviceLogsExportCmd.Flags().StringSliceVarP(&flagServiceLogsExportTasks, "task", "t", []string{}, "Export logs from specific task (can be specified multiple times)")
+	serviceLogsExportCmd.Flags().BoolVar(&flagServiceLogsExportGzip, "gzip", false, "Compress the log files with gzip")
+	serviceLogsExportCmd.Flags().IntVar(&flagServiceLogsExportConcurrency, "concurrency", defaultExportConcurrency, "Number of log streams to fetch concurrently")
+	serviceLogsExportCmd.Flags().BoolVar(&flagServiceLogsExportRestart, "restart", false, "Discard the progress of a previous export and start over")
+
+	serviceLogsExportCmd.MarkFlagRequired("start")
+	serviceLogsExportCmd.MarkFlagRequired("out")
+
+	serviceLogsCmd.AddCommand(serviceLogsExportCmd)
+}
@@ -0,0 +1,140 @@
+package cmd
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	awsecs "github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/turnerlabs/fargate/console"
+	ECS "github.com/turnerlabs/fargate/ecs"
+)
+
+const defaultFailedTaskLogLines = 20
+
+// returns whether a stopped task failed rather than being stopped by a
+// deployment or scaling activity
+func taskFailed(task ECS.Task) bool {
+	for _, container := range task.Containers {
+		if container.ExitCode != nil && *container.ExitCode != 0 {
+			return true
+		}
+	}
+
+	switch task.StopCode {
+	case awsecs.TaskStopCodeTaskFailedToStart, awsecs.TaskStopCodeEssentialContainerExited:
+		return true
+	}
+
+	return strings.Contains(strings.ToLower(task.StoppedReason), "health check")
+}
+
+// selects the tasks that stopped since a time, oldest first. If a task
+// definition is given, all of its stopped tasks are selected, otherwise only
+// the tasks that failed.
+func selectFailedTasks(tasks []ECS.Task, since time.Time, taskDefinitionArn string) []ECS.Task {
+	var failed []ECS.Task
+
+	for _, task := range tasks {
+		if task.StoppedAt.Before(since) {
+			continue
+		}
+
+		if taskDefinitionArn != "" {
+			if task.TaskDefinitionArn != taskDefinitionArn {
+				continue
+			}
+		} else if !taskFailed(task) {
+			continue
+		}
+
+		failed = append(failed, task)
+	}
+
+	sort.SliceStable(failed, func(i, j int) bool {
+		return failed[i].StoppedAt.Before(failed[j].StoppedAt)
+	})
+
+	return failed
+}
+
+// prints the stop reason and the last log lines of each of the failed tasks of
+// a service, using the output options of an operation; returns the number of
+// failed tasks
+func showFailedTasks(template *GetLogsOperation, serviceName string, since time.Time, taskDefinitionArn string) int {
+	ecs := ecsOrDefault(template.ecs)
+	stoppedTasks, err := ecs.DescribeStoppedTasksForService(serviceName)
+	if err != nil {
+		console.ErrorExit(err, "Could not list ECS tasks")
+	}
+
+	tasks := selectFailedTasks(stoppedTasks, since, taskDefinitionArn)
+
+	if len(tasks) == 0 {
+		console.Info("No tasks of service %s stopped since %s", serviceName, since.Local().Format(timeFormat))
+		return 0
+	}
+
+	taskDefinitions := make(map[string]*awsecs.TaskDefinition)
+
+	for _, task := range tasks {
+		console.KeyValue("Task", "%s\n", task.TaskId)
+		console.KeyValue("  Revision", "%s\n", ecs.GetRevisionNumber(task.TaskDefinitionArn))
+		console.KeyValue("  Stopped", "%s\n", formatHistoryTime(task.StoppedAt))
+
+		if task.StopCode != "" {
+			console.KeyValue("  Stop Code", "%s\n", task.StopCode)
+		}
+
+		console.KeyValue("  Reason", "%s\n", task.StoppedReason)
+		console.KeyValue("  Exit Codes", "%s\n", formatExitCodes(task.Containers))
+
+		for _, container := range task.Containers {
+			if container.Reason != "" {
+				console.KeyValue("  "+container.Name, "%s\n", container.Reason)
+			}
+		}
+
+		if taskDefinitions[task.TaskDefinitionArn] == nil {
+			dtd, err := ecs.DescribeTaskDefinition(task.TaskDefinitionArn)
+			if err != nil {
+				console.ErrorExit(err, "Could not describe ECS task definition")
+			}
+
+			taskDefinitions[task.TaskDefinitionArn] = dtd.TaskDefinition
+		}
+
+		logConfigurations := ECS.GetLogConfigurations(taskDefinitions[task.TaskDefinitionArn], fmt.Sprintf(serviceLogGroupFormat, serviceName))
+
+		operation := &GetLogsOperation{
+			Filter:            template.Filter,
+			IncludeTime:       template.IncludeTime,
+			NoLogStreamPrefix: template.NoLogStreamPrefix,
+			JSONFields:        template.JSONFields,
+			LevelFilter:       template.LevelFilter,
+			Grep:              template.Grep,
+			Output:            template.Output,
+			StartTime:         task.CreatedAt,
+			Tail:              template.Tail,
+			cwl:               template.cwl,
+		}
+
+		for _, logConfiguration := range logConfigurations {
+			var name string
+			if len(logConfigurations) > 1 {
+				name = logConfiguration.ContainerName
+			}
+
+			operation.AddLogSource(name, logConfiguration)
+		}
+
+		operation.AddTasks([]string{task.TaskId})
+
+		console.KeyValue("  Logs", "\n")
+		getLogs(operation)
+		fmt.Println()
+	}
+
+	return len(tasks)
+}
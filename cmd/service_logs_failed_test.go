@@ -0,0 +1,50 @@
+package cmd
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awsecs "github.com/aws/aws-sdk-go/service/ecs"
+	ECS "github.com/turnerlabs/fargate/ecs"
+)
+
+func TestTaskFailed(t *testing.T) {
+	tests := []struct {
+		task     ECS.Task
+		expected bool
+	}{
+		{ECS.Task{Containers: []ECS.Container{{Name: "app", ExitCode: aws.Int64(1)}}}, true},
+		{ECS.Task{Containers: []ECS.Container{{Name: "app", ExitCode: aws.Int64(0)}}, StopCode: awsecs.TaskStopCodeServiceSchedulerInitiated}, false},
+		{ECS.Task{StopCode: awsecs.TaskStopCodeTaskFailedToStart, StoppedReason: "CannotPullContainerError"}, true},
+		{ECS.Task{StopCode: awsecs.TaskStopCodeServiceSchedulerInitiated, StoppedReason: "Task failed ELB health checks"}, true},
+		{ECS.Task{StopCode: awsecs.TaskStopCodeServiceSchedulerInitiated, StoppedReason: "Scaling activity initiated by deployment"}, false},
+	}
+
+	for _, test := range tests {
+		if got := taskFailed(test.task); got != test.expected {
+			t.Errorf("Expected %t for %s (%s), got %t", test.expected, test.task.StopCode, test.task.StoppedReason, got)
+		}
+	}
+}
+
+func TestSelectFailedTasks(t *testing.T) {
+	now := time.Now()
+	tasks := []ECS.Task{
+		{TaskId: "new", TaskDefinitionArn: "td:2", StoppedAt: now, StopCode: awsecs.TaskStopCodeServiceSchedulerInitiated},
+		{TaskId: "crashed", TaskDefinitionArn: "td:2", StoppedAt: now.Add(-time.Minute), StopCode: awsecs.TaskStopCodeEssentialContainerExited},
+		{TaskId: "old", TaskDefinitionArn: "td:1", StoppedAt: now.Add(-time.Hour), StopCode: awsecs.TaskStopCodeEssentialContainerExited},
+	}
+
+	failed := selectFailedTasks(tasks, now.Add(-10*time.Minute), "")
+
+	if len(failed) != 1 || failed[0].TaskId != "crashed" {
+		t.Errorf("Expected [crashed], got %v", failed)
+	}
+
+	stopped := selectFailedTasks(tasks, now.Add(-2*time.Hour), "td:2")
+
+	if len(stopped) != 2 || stopped[0].TaskId != "crashed" || stopped[1].TaskId != "new" {
+		t.Errorf("Expected [crashed new], got %v", stopped)
+	}
+}
@@ -0,0 +1,9 @@
+package cmd
+
+var serviceLogsQueryCmd = newLogsQueryCmd("service", "the log group of the service", func() string {
+	return getServiceLogConfiguration(getServiceName()).LogGroupName
+})
+
+func init() {
+	serviceLogsCmd.AddCommand(serviceLogsQueryCmd)
+}
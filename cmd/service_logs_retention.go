@@ -0,0 +1,223 @@
+package cmd
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+	CWL "github.com/turnerlabs/fargate/cloudwatchlogs"
+	"github.com/turnerlabs/fargate/console"
+)
+
+const retentionNever = "never"
+
+// the retention periods supported by CloudWatch Logs
+var validRetentionDays = []int64{1, 3, 5, 7, 14, 30, 60, 90, 120, 150, 180, 365, 400, 545, 731, 1096, 1827, 2192, 2557, 2922, 3288, 3653}
+
+var retentionPeriodPattern = regexp.MustCompile(`^(\d+)([dy]?)$`)
+
+var flagServiceLogsRetentionAllServices bool
+
+var serviceLogsRetentionCmd = &cobra.Command{
+	Use:   "retention",
+	Short: "Manage the retention of service logs",
+	Long: `Manage the retention of service logs
+
+Log groups keep their events forever unless a retention period is set. The
+retention applies to the log groups of all containers of the service, or of all
+services in the cluster with --all-services.`,
+}
+
+var serviceLogsRetentionSetCmd = &cobra.Command{
+	Use:   "set <period>",
+	Short: "Set how long service logs are kept",
+	Long: `Set how long service logs are kept
+
+The period is a number of days (e.g. 30 or 30d), a number of years (e.g. 1y),
+or "never" to keep logs forever. CloudWatch Logs supports the following numbers
+of days: 1, 3, 5, 7, 14, 30, 60, 90, 120, 150, 180, 365, 400, 545, 731, 1096,
+1827, 2192, 2557, 2922, 3288 and 3653.`,
+	Example: `
+fargate service logs retention set 30d
+fargate service logs retention set 1y
+fargate service logs retention set never
+fargate service logs retention set 90d --all-services
+`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		days, err := parseRetentionPeriod(args[0])
+		if err != nil {
+			console.ErrorExit(err, "Invalid retention period")
+		}
+
+		setServiceLogsRetention(getServiceLogsRetentionServices(cmd), days)
+	},
+}
+
+var serviceLogsRetentionInfoCmd = &cobra.Command{
+	Use:   "info",
+	Short: "Show the retention and size of service logs",
+	Long: `Show the retention and size of service logs
+
+Shows the retention period, stored bytes and subscription filters of the log
+groups of the service, or of all services in the cluster with --all-services.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		serviceLogsRetentionInfo(getServiceLogsRetentionServices(cmd))
+	},
+}
+
+func init() {
+	serviceLogsRetentionCmd.PersistentFlags().BoolVar(&flagServiceLogsRetentionAllServices, "all-services", false, "Apply to all services in the cluster")
+
+	serviceLogsRetentionCmd.AddCommand(serviceLogsRetentionSetCmd)
+	serviceLogsRetentionCmd.AddCommand(serviceLogsRetentionInfoCmd)
+	serviceLogsCmd.AddCommand(serviceLogsRetentionCmd)
+}
+
+// returns all services of the cluster with --all-services, or the configured service
+func getServiceLogsRetentionServices(cmd *cobra.Command) []string {
+	if flagServiceLogsRetentionAllServices {
+		if cmd.Flags().Changed(keyService) {
+			console.IssueExit("--service and --all-services cannot be used together")
+		}
+
+		return getClusterServiceNames()
+	}
+
+	return []string{getServiceName()}
+}
+
+// parses a retention period into a number of days supported by CloudWatch
+// Logs; never is returned as 0
+func parseRetentionPeriod(raw string) (int64, error) {
+	raw = strings.ToLower(strings.TrimSpace(raw))
+
+	if raw == retentionNever {
+		return 0, nil
+	}
+
+	matches := retentionPeriodPattern.FindStringSubmatch(raw)
+	if matches == nil {
+		return 0, fmt.Errorf("%s is not a number of days (e.g. 30d), years (e.g. 1y) or never", raw)
+	}
+
+	value, err := strconv.ParseInt(matches[1], 10, 64)
+	if err != nil {
+		return 0, err
+	}
+
+	// never is the only way to remove the retention
+	if value < 1 {
+		return 0, fmt.Errorf("%s is not a retention period (use never to keep logs forever)", raw)
+	}
+
+	// years map to the closest supported number of days (e.g. 2y is 731 days)
+	if matches[2] == "y" {
+		for _, days := range validRetentionDays {
+			if days >= value*365 && days < (value+1)*365 {
+				return days, nil
+			}
+		}
+
+		return 0, fmt.Errorf("%d years is not a supported retention period", value)
+	}
+
+	for _, days := range validRetentionDays {
+		if days == value {
+			return days, nil
+		}
+	}
+
+	return 0, fmt.Errorf("%d days is not a supported retention period (valid: %s)", value, formatRetentionDays())
+}
+
+func formatRetentionDays() string {
+	var days []string
+
+	for _, d := range validRetentionDays {
+		days = append(days, strconv.FormatInt(d, 10))
+	}
+
+	return strings.Join(days, ", ")
+}
+
+func formatRetention(days int64) string {
+	if days == 0 {
+		return "Never expire"
+	}
+
+	return fmt.Sprintf("%d days", days)
+}
+
+// formats a number of bytes with a binary unit
+func formatBytes(bytes int64) string {
+	const unit = 1024
+
+	if bytes < unit {
+		return fmt.Sprintf("%d B", bytes)
+	}
+
+	div, exp := int64(unit), 0
+	for n := bytes / unit; n >= unit; n /= unit {
+		div *= unit
+		exp++
+	}
+
+	return fmt.Sprintf("%.1f %ciB", float64(bytes)/float64(div), "KMGTPE"[exp])
+}
+
+func setServiceLogsRetention(serviceNames []string, days int64) {
+	cwl := CWL.New(sess)
+
+	for _, serviceName := range serviceNames {
+		for _, logGroupName := range getServiceLogGroupNames(serviceName) {
+			if days == 0 {
+				if err := cwl.DeleteRetentionPolicy(logGroupName); err != nil {
+					console.ErrorExit(err, "Could not remove retention of log group %s", logGroupName)
+				}
+			} else if err := cwl.SetRetentionPolicy(logGroupName, days); err != nil {
+				console.ErrorExit(err, "Could not set retention of log group %s", logGroupName)
+			}
+
+			console.Info("Set retention of log group %s to %s", logGroupName, strings.ToLower(formatRetention(days)))
+		}
+	}
+}
+
+func serviceLogsRetentionInfo(serviceNames []string) {
+	cwl := CWL.New(sess)
+
+	for _, serviceName := range serviceNames {
+		for _, logGroupName := range getServiceLogGroupNames(serviceName) {
+			printLogGroupRetentionInfo(&cwl, logGroupName)
+		}
+	}
+}
+
+func printLogGroupRetentionInfo(cwl *CWL.CloudWatchLogs, logGroupName string) {
+	logGroup, err := cwl.DescribeLogGroup(logGroupName)
+	if err != nil {
+		console.ErrorExit(err, "Could not describe log group %s", logGroupName)
+	}
+
+	console.KeyValue("Log Group", "%s\n", logGroup.Name)
+	console.KeyValue("  Retention", "%s\n", formatRetention(logGroup.RetentionInDays))
+	console.KeyValue("  Stored Bytes", "%s\n", formatBytes(logGroup.StoredBytes))
+	console.KeyValue("  Created", "%s\n", logGroup.CreationTime.Format(timeFormatWithZone))
+
+	filters, err := cwl.ListSubscriptionFilters(logGroupName)
+	if err != nil {
+		console.ErrorExit(err, "Could not list subscription filters of log group %s", logGroupName)
+	}
+
+	if len(filters) == 0 {
+		console.KeyValue("  Subscriptions", "None\n")
+		return
+	}
+
+	for _, filter := range filters {
+		console.KeyValue("  Subscription", "%s -> %s\n", filter.Name, filter.DestinationArn)
+	}
+}
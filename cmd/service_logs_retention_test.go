@@ -0,0 +1,53 @@
+package cmd
+
+import (
+	"testing"
+)
+
+func TestParseRetentionPeriod(t *testing.T) {
+	tests := []struct {
+		raw  string
+		days int64
+	}{
+		{"30d", 30},
+		{"14", 14},
+		{"1y", 365},
+		{"2y", 731},
+		{"5Y", 1827},
+		{"10y", 3653},
+		{"never", 0},
+	}
+
+	for _, test := range tests {
+		days, err := parseRetentionPeriod(test.raw)
+		if err != nil {
+			t.Errorf("Unexpected error for %s: %v", test.raw, err)
+		}
+
+		if days != test.days {
+			t.Errorf("Expected %d for %s, got %d", test.days, test.raw, days)
+		}
+	}
+
+	for _, raw := range []string{"31d", "4y", "0y", "0d", "0", "30w", "forever", ""} {
+		if _, err := parseRetentionPeriod(raw); err == nil {
+			t.Errorf("Expected error for %s, got nil", raw)
+		}
+	}
+}
+
+func TestFormatBytes(t *testing.T) {
+	tests := map[int64]string{
+		0:          "0 B",
+		1023:       "1023 B",
+		1536:       "1.5 KiB",
+		5242880:    "5.0 MiB",
+		3221225472: "3.0 GiB",
+	}
+
+	for bytes, expected := range tests {
+		if got := formatBytes(bytes); got != expected {
+			t.Errorf("Expected %s, got %s", expected, got)
+		}
+	}
+}
@@ -36,7 +36,10 @@ func getServiceProcessList(operation *ServiceProcessListOperation) {
 
 	ecs := ECS.New(sess, getClusterName())
 	ec2 := EC2.New(sess)
-	tasks := ecs.DescribeTasksForService(operation.ServiceName)
+	tasks, err := ecs.DescribeTasksForService(operation.ServiceName)
+	if err != nil {
+		console.ErrorExit(err, "Could not list ECS tasks")
+	}
 
 	for _, task := range tasks {
 		if task.EniId != "" {
@@ -45,7 +48,10 @@ func getServiceProcessList(operation *ServiceProcessListOperation) {
 	}
 
 	if len(tasks) > 0 {
-		enis := ec2.DescribeNetworkInterfaces(eniIds)
+		enis, err := ec2.DescribeNetworkInterfaces(eniIds)
+		if err != nil {
+			console.ErrorExit(err, "Could not describe network interfaces")
+		}
 
 		w := new(tabwriter.Writer)
 		w.Init(os.Stdout, 0, 8, 1, '\t', 0)
@@ -1,6 +1,7 @@
 package cmd
 
 import (
+	"github.com/turnerlabs/fargate/awserrors"
 	"github.com/turnerlabs/fargate/console"
 	ECS "github.com/turnerlabs/fargate/ecs"
 	"github.com/spf13/cobra"
@@ -34,6 +35,13 @@ func init() {
 func restartService(operation *ServiceRestartOperation) {
 	ecs := ECS.New(sess, getClusterName())
 
-	ecs.RestartService(operation.ServiceName)
+	if err := ecs.RestartService(operation.ServiceName); err != nil {
+		if awserrors.IsNotFound(err) {
+			console.IssueExit("Service %s not found", operation.ServiceName)
+		}
+
+		console.ErrorExit(err, "Could not restart service")
+	}
+
 	console.Info("Restarted %s", operation.ServiceName)
 }
@@ -15,10 +15,11 @@ const validScalePattern = "[-\\+]?[0-9]+"
 type ScaleServiceOperation struct {
 	ServiceName  string
 	DesiredCount int64
+
+	ecs ECS.ECS
 }
 
 func (o *ScaleServiceOperation) SetScale(scaleExpression string) {
-	ecs := ECS.New(sess, getClusterName())
 	validScale := regexp.MustCompile(validScalePattern)
 
 	if !validScale.MatchString(scaleExpression) {
@@ -27,7 +28,11 @@ func (o *ScaleServiceOperation) SetScale(scaleExpression string) {
 
 	if scaleExpression[0] == '+' || scaleExpression[0] == '-' {
 		if s, err := strconv.ParseInt(scaleExpression[1:len(scaleExpression)], 10, 64); err == nil {
-			currentDesiredCount := ecs.GetDesiredCount(o.ServiceName)
+			currentDesiredCount, err := o.ecs.GetDesiredCount(o.ServiceName)
+			if err != nil {
+				console.ErrorExit(err, "Could not describe ECS service")
+			}
+
 			if scaleExpression[0] == '+' {
 				o.DesiredCount = currentDesiredCount + s
 			} else if scaleExpression[0] == '-' {
@@ -57,6 +62,7 @@ specified with a sign such as +5 or -2.`,
 	Run: func(cmd *cobra.Command, args []string) {
 		operation := &ScaleServiceOperation{
 			ServiceName: getServiceName(),
+			ecs:         ECS.New(sess, getClusterName()),
 		}
 
 		operation.SetScale(args[0])
@@ -70,8 +76,9 @@ func init() {
 }
 
 func scaleService(operation *ScaleServiceOperation) {
-	ecs := ECS.New(sess, getClusterName())
+	if err := operation.ecs.SetDesiredCount(operation.ServiceName, operation.DesiredCount); err != nil {
+		console.ErrorExit(err, "Could not scale ECS service")
+	}
 
-	ecs.SetDesiredCount(operation.ServiceName, operation.DesiredCount)
 	console.Info("Scaled service %s to %d", operation.ServiceName, operation.DesiredCount)
 }
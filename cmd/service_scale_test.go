@@ -0,0 +1,73 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awsecs "github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/golang/mock/gomock"
+	ECS "github.com/turnerlabs/fargate/ecs"
+	"github.com/turnerlabs/fargate/ecs/mock/sdk"
+)
+
+func TestSetScale(t *testing.T) {
+	operation := &ScaleServiceOperation{ServiceName: "web"}
+
+	operation.SetScale("4")
+
+	if operation.DesiredCount != 4 {
+		t.Errorf("Expected 4, got %d", operation.DesiredCount)
+	}
+}
+
+func TestSetScaleDelta(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mockECSClient := sdk.NewMockECSAPI(mockCtrl)
+	mockECSClient.EXPECT().DescribeServices(gomock.Any()).Return(
+		&awsecs.DescribeServicesOutput{
+			Services: []*awsecs.Service{
+				&awsecs.Service{
+					ServiceName:          aws.String("web"),
+					DesiredCount:         aws.Int64(3),
+					TaskDefinition:       aws.String("arn:aws:ecs:us-east-1:123456789012:task-definition/scale-delta:1"),
+					NetworkConfiguration: &awsecs.NetworkConfiguration{},
+				},
+			},
+		},
+		nil,
+	)
+	expectDescribeTaskDefinition(mockECSClient, "arn:aws:ecs:us-east-1:123456789012:task-definition/scale-delta:1")
+
+	operation := &ScaleServiceOperation{
+		ServiceName: "web",
+		ecs:         ECS.NewWithClient(mockECSClient, "my-cluster"),
+	}
+
+	operation.SetScale("-2")
+
+	if operation.DesiredCount != 1 {
+		t.Errorf("Expected 1, got %d", operation.DesiredCount)
+	}
+}
+
+func TestScaleService(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mockECSClient := sdk.NewMockECSAPI(mockCtrl)
+	mockECSClient.EXPECT().UpdateService(
+		&awsecs.UpdateServiceInput{
+			Cluster:      aws.String("my-cluster"),
+			Service:      aws.String("web"),
+			DesiredCount: aws.Int64(5),
+		},
+	).Return(&awsecs.UpdateServiceOutput{}, nil)
+
+	scaleService(&ScaleServiceOperation{
+		ServiceName:  "web",
+		DesiredCount: 5,
+		ecs:          ECS.NewWithClient(mockECSClient, "my-cluster"),
+	})
+}
@@ -22,8 +22,17 @@ func (o *ServiceUpdateOperation) Validate() {
 		console.ErrorExit(fmt.Errorf("--cpu and/or --memory must be supplied"), "Invalid command line arguments")
 	}
 
-	o.Service = ecs.DescribeService(o.ServiceName)
-	cpu, memory := ecs.GetCpuAndMemoryFromTaskDefinition(o.Service.TaskDefinitionArn)
+	service, err := ecs.DescribeService(o.ServiceName)
+	if err != nil {
+		console.ErrorExit(err, "Could not describe ECS service")
+	}
+
+	o.Service = service
+
+	cpu, memory, err := ecs.GetCpuAndMemoryFromTaskDefinition(o.Service.TaskDefinitionArn)
+	if err != nil {
+		console.ErrorExit(err, "Could not describe ECS task definition")
+	}
 
 	if o.Cpu == "" {
 		o.Cpu = cpu
@@ -33,7 +42,7 @@ func (o *ServiceUpdateOperation) Validate() {
 		o.Memory = memory
 	}
 
-	err := validateCpuAndMemory(o.Cpu, o.Memory)
+	err = validateCpuAndMemory(o.Cpu, o.Memory)
 
 	if err != nil {
 		console.ErrorExit(err, "Invalid settings: %s CPU units / %s MiB", o.Cpu, o.Memory)
@@ -87,12 +96,18 @@ func init() {
 func updateService(operation *ServiceUpdateOperation) {
 	ecs := ECS.New(sess, getClusterName())
 
-	newTaskDefinitionArn := ecs.UpdateTaskDefinitionCpuAndMemory(
+	newTaskDefinitionArn, err := ecs.UpdateTaskDefinitionCpuAndMemory(
 		operation.Service.TaskDefinitionArn,
 		operation.Cpu,
 		operation.Memory,
 	)
+	if err != nil {
+		console.ErrorExit(err, "Could not register ECS task definition")
+	}
+
+	if err := ecs.UpdateServiceTaskDefinition(operation.ServiceName, newTaskDefinitionArn); err != nil {
+		console.ErrorExit(err, "Could not update ECS service task definition")
+	}
 
-	ecs.UpdateServiceTaskDefinition(operation.ServiceName, newTaskDefinitionArn)
 	console.Info("Updated service %s to %s CPU units / %s MiB", operation.ServiceName, operation.Cpu, operation.Memory)
 }
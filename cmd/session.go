@@ -0,0 +1,184 @@
+package cmd
+
+import (
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/aws/credentials/stscreds"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/acm"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/aws/aws-sdk-go/service/cloudwatchevents"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/aws/aws-sdk-go/service/servicediscovery"
+	"github.com/aws/aws-sdk-go/service/sts"
+)
+
+// the services whose endpoints can be set in the endpoints of fargate.yml, by
+// the id the SDK resolves their endpoints with
+var endpointServices = map[string]string{
+	"acm":              acm.EndpointsID,
+	"cloudwatch":       cloudwatch.EndpointsID,
+	"ec2":              ec2.EndpointsID,
+	"ecs":              ecs.EndpointsID,
+	"elbv2":            elbv2.EndpointsID,
+	"events":           cloudwatchevents.EndpointsID,
+	"logs":             cloudwatchlogs.EndpointsID,
+	"servicediscovery": servicediscovery.EndpointsID,
+	"sts":              sts.EndpointsID,
+}
+
+// endpointOverrides replaces the endpoints of AWS services, e.g. with
+// LocalStack. Services without their own endpoint use URL, if set.
+type endpointOverrides struct {
+	URL      string
+	Services map[string]string
+}
+
+func (e endpointOverrides) enabled() bool {
+	return e.URL != "" || len(e.Services) > 0
+}
+
+func (e endpointOverrides) validate() error {
+	if e.URL != "" {
+		if err := validateEndpointURL(e.URL); err != nil {
+			return err
+		}
+	}
+
+	for service, endpoint := range e.Services {
+		if _, ok := endpointServices[service]; !ok {
+			var services []string
+
+			for name := range endpointServices {
+				services = append(services, name)
+			}
+
+			sort.Strings(services)
+
+			return fmt.Errorf("endpoint of unknown service %s [services: %s]", service, strings.Join(services, ", "))
+		}
+
+		if err := validateEndpointURL(endpoint); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// EndpointFor implements endpoints.Resolver, falling back on the default
+// endpoints of the SDK
+func (e endpointOverrides) EndpointFor(service, region string, opts ...func(*endpoints.Options)) (endpoints.ResolvedEndpoint, error) {
+	endpoint := e.URL
+
+	for name, serviceEndpoint := range e.Services {
+		if endpointServices[name] == service {
+			endpoint = serviceEndpoint
+		}
+	}
+
+	if endpoint == "" {
+		return endpoints.DefaultResolver().EndpointFor(service, region, opts...)
+	}
+
+	return endpoints.ResolvedEndpoint{
+		URL:           endpoint,
+		SigningRegion: region,
+	}, nil
+}
+
+func validateEndpointURL(endpoint string) error {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return err
+	}
+
+	if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("endpoint %s is not an http or https URL", endpoint)
+	}
+
+	return nil
+}
+
+// assumeRole is an IAM role to assume with the credentials of the session
+type assumeRole struct {
+	RoleArn         string
+	ExternalID      string
+	RoleSessionName string
+	MFASerial       string
+}
+
+func (r assumeRole) validate() error {
+	if r.RoleArn == "" {
+		if r.ExternalID != "" || r.RoleSessionName != "" || r.MFASerial != "" {
+			return fmt.Errorf("--external-id, --role-session-name and --mfa-serial require --role-arn")
+		}
+
+		return nil
+	}
+
+	role, err := arn.Parse(r.RoleArn)
+	if err != nil {
+		return err
+	}
+
+	if role.Service != "iam" {
+		return fmt.Errorf("%s is not an IAM role", r.RoleArn)
+	}
+
+	return nil
+}
+
+// returns a session using the shared configuration of a profile and the given
+// endpoints, assuming a role if given. MFA token codes are prompted for on
+// stdin, both for the role and for profiles that set mfa_serial.
+func newSession(region, profile string, role assumeRole, overrides endpointOverrides, verbose bool) *session.Session {
+	config := aws.Config{Region: aws.String(region)}
+
+	if overrides.enabled() {
+		config.EndpointResolver = overrides
+	}
+
+	if verbose {
+		config.LogLevel = aws.LogLevel(aws.LogDebugWithHTTPBody)
+	}
+
+	sess := session.Must(
+		session.NewSessionWithOptions(session.Options{
+			SharedConfigState:       session.SharedConfigEnable,
+			Profile:                 profile,
+			Config:                  config,
+			AssumeRoleTokenProvider: stscreds.StdinTokenProvider,
+		}),
+	)
+
+	if role.RoleArn == "" {
+		return sess
+	}
+
+	credentials := stscreds.NewCredentials(sess, role.RoleArn, func(p *stscreds.AssumeRoleProvider) {
+		if role.ExternalID != "" {
+			p.ExternalID = aws.String(role.ExternalID)
+		}
+
+		if role.RoleSessionName != "" {
+			p.RoleSessionName = role.RoleSessionName
+		}
+
+		if role.MFASerial != "" {
+			p.SerialNumber = aws.String(role.MFASerial)
+			p.TokenProvider = stscreds.StdinTokenProvider
+		}
+	})
+
+	return sess.Copy(&aws.Config{Credentials: credentials})
+}
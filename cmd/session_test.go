@@ -0,0 +1,78 @@
+package cmd
+
+import (
+	"testing"
+)
+
+func TestAssumeRoleValidate(t *testing.T) {
+	tests := []struct {
+		role  assumeRole
+		valid bool
+	}{
+		{assumeRole{}, true},
+		{assumeRole{RoleArn: "arn:aws:iam::123456789012:role/deploy"}, true},
+		{assumeRole{RoleArn: "arn:aws:iam::123456789012:role/deploy", ExternalID: "abc", MFASerial: "arn:aws:iam::123456789012:mfa/me"}, true},
+		{assumeRole{ExternalID: "abc"}, false},
+		{assumeRole{MFASerial: "arn:aws:iam::123456789012:mfa/me"}, false},
+		{assumeRole{RoleArn: "deploy"}, false},
+		{assumeRole{RoleArn: "arn:aws:sns:us-east-1:123456789012:deploy"}, false},
+	}
+
+	for i, test := range tests {
+		if err := test.role.validate(); (err == nil) != test.valid {
+			t.Errorf("Expected valid %t for test %d, got %v", test.valid, i, err)
+		}
+	}
+}
+
+func TestEndpointOverridesValidate(t *testing.T) {
+	tests := []struct {
+		endpoints endpointOverrides
+		valid     bool
+	}{
+		{endpointOverrides{}, true},
+		{endpointOverrides{URL: "http://localhost:4566"}, true},
+		{endpointOverrides{Services: map[string]string{"ecs": "http://localhost:4566", "logs": "https://logs.example.com"}}, true},
+		{endpointOverrides{URL: "localhost:4566"}, false},
+		{endpointOverrides{Services: map[string]string{"s3": "http://localhost:4566"}}, false},
+		{endpointOverrides{Services: map[string]string{"ecs": "ftp://localhost"}}, false},
+	}
+
+	for i, test := range tests {
+		if err := test.endpoints.validate(); (err == nil) != test.valid {
+			t.Errorf("Expected valid %t for test %d, got %v", test.valid, i, err)
+		}
+	}
+}
+
+func TestEndpointOverridesEndpointFor(t *testing.T) {
+	overrides := endpointOverrides{
+		URL:      "http://localhost:4566",
+		Services: map[string]string{"elbv2": "http://localhost:4567"},
+	}
+
+	tests := map[string]string{
+		"ecs":                  "http://localhost:4566",
+		"elasticloadbalancing": "http://localhost:4567",
+	}
+
+	for service, expected := range tests {
+		endpoint, err := overrides.EndpointFor(service, "us-east-1")
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		if endpoint.URL != expected || endpoint.SigningRegion != "us-east-1" {
+			t.Errorf("Expected %s in us-east-1 for %s, got %s in %s", expected, service, endpoint.URL, endpoint.SigningRegion)
+		}
+	}
+
+	endpoint, err := endpointOverrides{Services: map[string]string{"ecs": "http://localhost:4566"}}.EndpointFor("logs", "us-east-1")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if endpoint.URL != "https://logs.us-east-1.amazonaws.com" {
+		t.Errorf("Expected https://logs.us-east-1.amazonaws.com, got %s", endpoint.URL)
+	}
+}
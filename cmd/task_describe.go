@@ -3,16 +3,32 @@ package cmd
 import (
 	"fmt"
 
+	awsecs "github.com/aws/aws-sdk-go/service/ecs"
 	"github.com/spf13/cobra"
 	"github.com/turnerlabs/fargate/console"
 	"github.com/turnerlabs/fargate/dockercompose"
 	ECS "github.com/turnerlabs/fargate/ecs"
 )
 
+const (
+	describeFormatCompose = "compose"
+	describeFormatJSON    = "json"
+)
+
+var flagTaskDescribeFormat string
+
 var taskDescribeCmd = &cobra.Command{
 	Use:   "describe",
-	Short: "Describe a task definition in docker compose format",
-	Run:   describe,
+	Short: "Describe a task definition in docker compose or JSON format",
+	Long: `Describe a task definition in docker compose or JSON format
+
+By default, the image, environment variables, secrets and ports of the first
+container are rendered in docker compose format.
+
+--format json renders the complete task definition, including tags, as a JSON
+document that can be registered with "fargate task register --from-json" or
+"aws ecs register-task-definition --cli-input-json".`,
+	Run: describe,
 	Example: `
 # with a fargate.yml present	
 fargate task describe
@@ -22,10 +38,15 @@ fargate task describe -t my-app
 
 # specify specific task definition family with revision
 fargate task describe -t my-app:42
+
+# export the complete task definition as JSON
+fargate task describe --format json > task-definition.json
 `,
 }
 
 func init() {
+	taskDescribeCmd.Flags().StringVar(&flagTaskDescribeFormat, "format", describeFormatCompose, "Output format (compose or json)")
+
 	taskCmd.AddCommand(taskDescribeCmd)
 }
 
@@ -33,9 +54,46 @@ func describe(cmd *cobra.Command, args []string) {
 	ecs := ECS.New(sess, "")
 
 	//lookup latest/active task definition from family
-	td := ecs.DescribeTaskDefinition(getTaskName()).TaskDefinition
+	dtd, err := ecs.DescribeTaskDefinition(getTaskName())
+	if err != nil {
+		console.ErrorExit(err, "Could not describe ECS task definition")
+	}
+
+	switch flagTaskDescribeFormat {
+	case describeFormatJSON:
+		describeJSON(dtd)
+	case describeFormatCompose:
+		describeCompose(dtd.TaskDefinition)
+	default:
+		console.IssueExit("Invalid format %s (specify compose or json)", flagTaskDescribeFormat)
+	}
+}
+
+// renders a task definition as a json document suitable for registering
+func describeJSON(dtd *awsecs.DescribeTaskDefinitionOutput) {
+	bits, err := ECS.MarshalTaskDefinitionInput(ECS.NewRegisterTaskDefinitionInput(dtd))
+	if err != nil {
+		console.ErrorExit(err, "marshalling error")
+	}
+
+	fmt.Println(string(bits))
+}
+
+// renders the first container of a task definition as a docker compose file
+func describeCompose(td *awsecs.TaskDefinition) {
+	yaml, err := composeFromTaskDefinition(td)
+	if err != nil {
+		console.ErrorExit(err, "Could not render docker compose file")
+	}
+
+	fmt.Println(string(yaml))
+}
+
+// returns a docker compose file for the first container of a task definition,
+// labeled to be deployed
+func composeFromTaskDefinition(td *awsecs.TaskDefinition) ([]byte, error) {
 	if len(td.ContainerDefinitions) == 0 {
-		console.IssueExit("No container found in task definition")
+		return nil, fmt.Errorf("no container found in task definition")
 	}
 	container := td.ContainerDefinitions[0]
 
@@ -67,10 +125,5 @@ func describe(cmd *cobra.Command, args []string) {
 	//indicate that this container should be deployed
 	service.Labels[deployDockerComposeLabel] = "1"
 
-	yaml, err := composeFile.Yaml()
-	if err != nil {
-		console.IssueExit("marshalling error: ", err)
-	}
-
-	fmt.Println(string(yaml))
+	return composeFile.Yaml()
 }
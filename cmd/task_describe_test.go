@@ -0,0 +1,37 @@
+package cmd
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awsecs "github.com/aws/aws-sdk-go/service/ecs"
+)
+
+func TestComposeFromTaskDefinition(t *testing.T) {
+	td := &awsecs.TaskDefinition{
+		ContainerDefinitions: []*awsecs.ContainerDefinition{
+			{
+				Name:         aws.String("web"),
+				Image:        aws.String("nginx:1.25"),
+				PortMappings: []*awsecs.PortMapping{{ContainerPort: aws.Int64(80)}},
+				Environment:  []*awsecs.KeyValuePair{{Name: aws.String("FOO"), Value: aws.String("bar")}},
+			},
+		},
+	}
+
+	yaml, err := composeFromTaskDefinition(td)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	for _, expected := range []string{"image: nginx:1.25", "FOO: bar", deployDockerComposeLabel} {
+		if !strings.Contains(string(yaml), expected) {
+			t.Errorf("Expected %s in compose file, got %s", expected, yaml)
+		}
+	}
+
+	if _, err := composeFromTaskDefinition(&awsecs.TaskDefinition{}); err == nil {
+		t.Errorf("Expected error, got nil")
+	}
+}
@@ -0,0 +1,346 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awsecs "github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/spf13/cobra"
+	"github.com/turnerlabs/fargate/console"
+	ECS "github.com/turnerlabs/fargate/ecs"
+)
+
+const (
+	diffFormatText = "text"
+	diffFormatJSON = "json"
+
+	changeAdded   = "added"
+	changeRemoved = "removed"
+	changeChanged = "changed"
+)
+
+var flagTaskDiffServices []string
+var flagTaskDiffFormat string
+
+// represents a task diff operation
+type taskDiffOperation struct {
+	Cluster   string
+	Task      string
+	Revisions []string
+	Services  []string
+	Format    string
+}
+
+func (o *taskDiffOperation) validate() {
+	if len(o.Services) == 0 && len(o.Revisions) != 2 {
+		console.IssueExit("Two revisions or two services (--service) must be specified")
+	}
+
+	if len(o.Services) > 0 && (len(o.Services) != 2 || len(o.Revisions) > 0) {
+		console.IssueExit("--service must be specified exactly twice and cannot be combined with revisions")
+	}
+
+	if o.Format != diffFormatText && o.Format != diffFormatJSON {
+		console.IssueExit("Invalid format %s (specify text or json)", o.Format)
+	}
+}
+
+// taskDefinitionChange represents a single difference between two task definitions
+type taskDefinitionChange struct {
+	Path   string `json:"path"`
+	Change string `json:"change"`
+	Old    string `json:"old,omitempty"`
+	New    string `json:"new,omitempty"`
+}
+
+// taskDefinitionDiff is the result of comparing two task definitions
+type taskDefinitionDiff struct {
+	From    string                 `json:"from"`
+	To      string                 `json:"to"`
+	Changes []taskDefinitionChange `json:"changes"`
+}
+
+var taskDiffCmd = &cobra.Command{
+	Use:   "diff <rev-a> <rev-b>",
+	Short: "Show the differences between two task definition revisions",
+	Long: `Show the differences between two task definition revisions
+
+Compares two revisions of a task definition family and shows which container
+images, environment variables, secrets (ARNs only), port mappings, cpu/memory,
+roles, volumes, log configuration and tags were added, removed or changed.
+
+Revisions can either be absolute or a delta specified with a sign such as +2 or
+-1, relative to the latest revision of the family. Since a leading "-" is
+interpreted as a flag, separate revision expressions from flags with "--".
+
+Alternatively, the current task definitions of two services can be compared by
+passing --service twice.
+
+--format json returns the changes in a machine-readable format.`,
+	Example: `
+fargate task diff 41 42
+fargate task diff -t my-app -- -1 +0
+fargate task diff --service my-app-dev --service my-app-prod -c my-cluster
+fargate task diff 41 42 --format json
+`,
+	Run: func(cmd *cobra.Command, args []string) {
+		operation := &taskDiffOperation{
+			Revisions: args,
+			Services:  flagTaskDiffServices,
+			Format:    flagTaskDiffFormat,
+		}
+
+		operation.validate()
+
+		if len(operation.Services) > 0 {
+			operation.Cluster = getClusterName()
+		} else {
+			operation.Task = getTaskName()
+		}
+
+		taskDiff(operation)
+	},
+}
+
+func init() {
+	taskDiffCmd.Flags().StringSliceVar(&flagTaskDiffServices, "service", []string{}, "Compare the current task definitions of two services (specify twice)")
+	taskDiffCmd.Flags().StringVar(&flagTaskDiffFormat, "format", diffFormatText, "Output format (text or json)")
+
+	taskCmd.AddCommand(taskDiffCmd)
+}
+
+func taskDiff(op *taskDiffOperation) {
+	ecs := ECS.New(sess, op.Cluster)
+
+	var fromArn, toArn string
+
+	if len(op.Services) > 0 {
+		fromService, err := ecs.DescribeService(op.Services[0])
+		if err != nil {
+			console.ErrorExit(err, "Could not describe ECS service")
+		}
+
+		toService, err := ecs.DescribeService(op.Services[1])
+		if err != nil {
+			console.ErrorExit(err, "Could not describe ECS service")
+		}
+
+		fromArn = fromService.TaskDefinitionArn
+		toArn = toService.TaskDefinitionArn
+	} else {
+		fromArn = resolveTaskDefinitionRevision(&ecs, op.Task, op.Revisions[0])
+		toArn = resolveTaskDefinitionRevision(&ecs, op.Task, op.Revisions[1])
+	}
+
+	from, err := ecs.DescribeTaskDefinition(fromArn)
+	if err != nil {
+		console.ErrorExit(err, "Could not describe ECS task definition")
+	}
+
+	to, err := ecs.DescribeTaskDefinition(toArn)
+	if err != nil {
+		console.ErrorExit(err, "Could not describe ECS task definition")
+	}
+
+	diff := taskDefinitionDiff{
+		From:    fromArn,
+		To:      toArn,
+		Changes: diffTaskDefinitions(from, to),
+	}
+
+	if op.Format == diffFormatJSON {
+		bits, err := json.MarshalIndent(diff, "", "  ")
+		if err != nil {
+			console.ErrorExit(err, "Could not marshal task definition diff")
+		}
+
+		fmt.Println(string(bits))
+		return
+	}
+
+	printTaskDefinitionDiff(diff)
+}
+
+// resolves a revision expression within a task family to a task definition arn
+func resolveTaskDefinitionRevision(ecs *ECS.ECS, family, revisionExpression string) string {
+	latest, err := ecs.DescribeTaskDefinition(family)
+	if err != nil {
+		console.ErrorExit(err, "Could not describe ECS task definition")
+	}
+
+	latestArn := aws.StringValue(latest.TaskDefinition.TaskDefinitionArn)
+	revisionNumber := ecs.ResolveRevisionNumber(latestArn, revisionExpression)
+
+	if revisionNumber == "" {
+		console.IssueExit("Could not resolve revision %s", revisionExpression)
+	}
+
+	revision, err := ecs.DescribeTaskDefinition(ecs.GetTaskFamily(latestArn) + ":" + revisionNumber)
+	if err != nil {
+		console.ErrorExit(err, "Could not describe ECS task definition")
+	}
+
+	return aws.StringValue(revision.TaskDefinition.TaskDefinitionArn)
+}
+
+func printTaskDefinitionDiff(diff taskDefinitionDiff) {
+	var removed, added, changed, colorReset string
+
+	if console.Color {
+		removed, added, changed, colorReset = red, green, yellow, reset
+	}
+
+	fmt.Printf("%s--- %s%s\n", removed, diff.From, colorReset)
+	fmt.Printf("%s+++ %s%s\n", added, diff.To, colorReset)
+
+	if len(diff.Changes) == 0 {
+		fmt.Println("No differences")
+		return
+	}
+
+	for _, c := range diff.Changes {
+		switch c.Change {
+		case changeAdded:
+			fmt.Printf("%s+ %s: %s%s\n", added, c.Path, c.New, colorReset)
+		case changeRemoved:
+			fmt.Printf("%s- %s: %s%s\n", removed, c.Path, c.Old, colorReset)
+		default:
+			fmt.Printf("%s~ %s: %s -> %s%s\n", changed, c.Path, c.Old, c.New, colorReset)
+		}
+	}
+}
+
+// diffTaskDefinitions compares two task definitions (including tags) and returns
+// the changes ordered by path
+func diffTaskDefinitions(from, to *awsecs.DescribeTaskDefinitionOutput) []taskDefinitionChange {
+	changes := []taskDefinitionChange{}
+
+	fromValues := flattenTaskDefinition(from)
+	toValues := flattenTaskDefinition(to)
+
+	var paths []string
+	for path := range fromValues {
+		paths = append(paths, path)
+	}
+	for path := range toValues {
+		if _, ok := fromValues[path]; !ok {
+			paths = append(paths, path)
+		}
+	}
+	sort.Strings(paths)
+
+	for _, path := range paths {
+		oldValue, inOld := fromValues[path]
+		newValue, inNew := toValues[path]
+
+		switch {
+		case inOld && !inNew:
+			changes = append(changes, taskDefinitionChange{Path: path, Change: changeRemoved, Old: oldValue})
+		case !inOld && inNew:
+			changes = append(changes, taskDefinitionChange{Path: path, Change: changeAdded, New: newValue})
+		case oldValue != newValue:
+			changes = append(changes, taskDefinitionChange{Path: path, Change: changeChanged, Old: oldValue, New: newValue})
+		}
+	}
+
+	return changes
+}
+
+// flattens the comparable parts of a task definition into path/value pairs
+func flattenTaskDefinition(dtd *awsecs.DescribeTaskDefinitionOutput) map[string]string {
+	result := make(map[string]string)
+
+	set := func(path string, value *string) {
+		if v := aws.StringValue(value); v != "" {
+			result[path] = v
+		}
+	}
+
+	setInt := func(path string, value *int64) {
+		if value != nil {
+			result[path] = strconv.FormatInt(aws.Int64Value(value), 10)
+		}
+	}
+
+	for _, tag := range dtd.Tags {
+		set("tags."+aws.StringValue(tag.Key), tag.Value)
+	}
+
+	td := dtd.TaskDefinition
+	if td == nil {
+		return result
+	}
+
+	set("cpu", td.Cpu)
+	set("memory", td.Memory)
+	set("taskRoleArn", td.TaskRoleArn)
+	set("executionRoleArn", td.ExecutionRoleArn)
+
+	for _, volume := range td.Volumes {
+		result["volumes."+aws.StringValue(volume.Name)] = describeVolume(volume)
+	}
+
+	for _, container := range td.ContainerDefinitions {
+		prefix := "containers." + aws.StringValue(container.Name) + "."
+
+		set(prefix+"image", container.Image)
+		setInt(prefix+"cpu", container.Cpu)
+		setInt(prefix+"memory", container.Memory)
+		setInt(prefix+"memoryReservation", container.MemoryReservation)
+
+		for _, env := range container.Environment {
+			result[prefix+"environment."+aws.StringValue(env.Name)] = aws.StringValue(env.Value)
+		}
+
+		for _, secret := range container.Secrets {
+			set(prefix+"secrets."+aws.StringValue(secret.Name), secret.ValueFrom)
+		}
+
+		for _, port := range container.PortMappings {
+			protocol := aws.StringValue(port.Protocol)
+			if protocol == "" {
+				protocol = awsecs.TransportProtocolTcp
+			}
+
+			result[fmt.Sprintf("%sportMappings.%d/%s", prefix, aws.Int64Value(port.ContainerPort), protocol)] =
+				strconv.FormatInt(aws.Int64Value(port.HostPort), 10)
+		}
+
+		for _, mountPoint := range container.MountPoints {
+			result[prefix+"mountPoints."+aws.StringValue(mountPoint.ContainerPath)] =
+				fmt.Sprintf("%s (readOnly=%t)", aws.StringValue(mountPoint.SourceVolume), aws.BoolValue(mountPoint.ReadOnly))
+		}
+
+		if logConfiguration := container.LogConfiguration; logConfiguration != nil {
+			set(prefix+"logConfiguration.logDriver", logConfiguration.LogDriver)
+
+			for key, value := range logConfiguration.Options {
+				set(prefix+"logConfiguration.options."+key, value)
+			}
+
+			for _, secret := range logConfiguration.SecretOptions {
+				set(prefix+"logConfiguration.secretOptions."+aws.StringValue(secret.Name), secret.ValueFrom)
+			}
+		}
+	}
+
+	return result
+}
+
+// returns a short, comparable description of a volume
+func describeVolume(volume *awsecs.Volume) string {
+	switch {
+	case volume.EfsVolumeConfiguration != nil:
+		efs := volume.EfsVolumeConfiguration
+		return "efs:" + aws.StringValue(efs.FileSystemId) + aws.StringValue(efs.RootDirectory)
+	case volume.DockerVolumeConfiguration != nil:
+		return "docker:" + aws.StringValue(volume.DockerVolumeConfiguration.Driver)
+	case volume.Host != nil && volume.Host.SourcePath != nil:
+		return "host:" + aws.StringValue(volume.Host.SourcePath)
+	default:
+		return "task"
+	}
+}
@@ -0,0 +1,94 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awsecs "github.com/aws/aws-sdk-go/service/ecs"
+)
+
+func TestDiffTaskDefinitions(t *testing.T) {
+	from := &awsecs.DescribeTaskDefinitionOutput{
+		TaskDefinition: &awsecs.TaskDefinition{
+			Cpu:         aws.String("256"),
+			Memory:      aws.String("512"),
+			TaskRoleArn: aws.String("arn:aws:iam::000000000000:role/my-app"),
+			ContainerDefinitions: []*awsecs.ContainerDefinition{
+				&awsecs.ContainerDefinition{
+					Name:  aws.String("app"),
+					Image: aws.String("my-app:1.0"),
+					Environment: []*awsecs.KeyValuePair{
+						&awsecs.KeyValuePair{Name: aws.String("FOO"), Value: aws.String("bar")},
+						&awsecs.KeyValuePair{Name: aws.String("REMOVED"), Value: aws.String("yes")},
+					},
+					PortMappings: []*awsecs.PortMapping{
+						&awsecs.PortMapping{ContainerPort: aws.Int64(8080), HostPort: aws.Int64(8080)},
+					},
+				},
+			},
+		},
+		Tags: []*awsecs.Tag{
+			&awsecs.Tag{Key: aws.String("env"), Value: aws.String("dev")},
+		},
+	}
+
+	to := &awsecs.DescribeTaskDefinitionOutput{
+		TaskDefinition: &awsecs.TaskDefinition{
+			Cpu:         aws.String("512"),
+			Memory:      aws.String("512"),
+			TaskRoleArn: aws.String("arn:aws:iam::000000000000:role/my-app"),
+			ContainerDefinitions: []*awsecs.ContainerDefinition{
+				&awsecs.ContainerDefinition{
+					Name:  aws.String("app"),
+					Image: aws.String("my-app:1.1"),
+					Environment: []*awsecs.KeyValuePair{
+						&awsecs.KeyValuePair{Name: aws.String("FOO"), Value: aws.String("bar")},
+					},
+					Secrets: []*awsecs.Secret{
+						&awsecs.Secret{Name: aws.String("KEY"), ValueFrom: aws.String("arn:aws:ssm:us-east-1:000000000000:parameter/key")},
+					},
+					PortMappings: []*awsecs.PortMapping{
+						&awsecs.PortMapping{ContainerPort: aws.Int64(8080), HostPort: aws.Int64(8080)},
+					},
+				},
+			},
+		},
+		Tags: []*awsecs.Tag{
+			&awsecs.Tag{Key: aws.String("env"), Value: aws.String("dev")},
+		},
+	}
+
+	expected := []taskDefinitionChange{
+		{Path: "containers.app.environment.REMOVED", Change: changeRemoved, Old: "yes"},
+		{Path: "containers.app.image", Change: changeChanged, Old: "my-app:1.0", New: "my-app:1.1"},
+		{Path: "containers.app.secrets.KEY", Change: changeAdded, New: "arn:aws:ssm:us-east-1:000000000000:parameter/key"},
+		{Path: "cpu", Change: changeChanged, Old: "256", New: "512"},
+	}
+
+	got := diffTaskDefinitions(from, to)
+
+	if len(got) != len(expected) {
+		t.Fatalf("Expected %d changes, got %d: %v", len(expected), len(got), got)
+	}
+
+	for i, change := range got {
+		if change != expected[i] {
+			t.Errorf("Expected %v, got %v", expected[i], change)
+		}
+	}
+}
+
+func TestDiffTaskDefinitions_NoChanges(t *testing.T) {
+	dtd := &awsecs.DescribeTaskDefinitionOutput{
+		TaskDefinition: &awsecs.TaskDefinition{
+			Cpu: aws.String("256"),
+			Volumes: []*awsecs.Volume{
+				&awsecs.Volume{Name: aws.String("data")},
+			},
+		},
+	}
+
+	if got := diffTaskDefinitions(dtd, dtd); len(got) != 0 {
+		t.Errorf("Expected no changes, got %v", got)
+	}
+}
@@ -1,9 +1,8 @@
 package cmd
 
 import (
-	"fmt"
-
 	"github.com/spf13/cobra"
+	CWL "github.com/turnerlabs/fargate/cloudwatchlogs"
 )
 
 var (
@@ -15,6 +14,14 @@ var (
 	flagTaskLogsContainerName     string
 	flagTaskLogsTime              bool
 	flagTaskLogsNoLogStreamPrefix bool
+	flagTaskLogsJSONFields        []string
+	flagTaskLogsLevel             string
+	flagTaskLogsOutput            string
+	flagTaskLogsSinceLast         bool
+	flagTaskLogsGrep              string
+	flagTaskLogsBeforeContext     int
+	flagTaskLogsAfterContext      int
+	flagTaskLogsInvert            bool
 )
 
 var taskLogsCmd = &cobra.Command{
@@ -22,19 +29,27 @@ var taskLogsCmd = &cobra.Command{
 	Short: "Show logs from tasks",
 	Long: `Show logs from tasks
 
-Assumes a cloudwatch log group with the following convention: fargate/task/<task>
-where task is specified via --task, or fargate.yml, or environment variable options
+The log group and log stream prefix are read from the awslogs-group and
+awslogs-stream-prefix options of the log configuration of the container in the
+latest task definition of the task family, where task is specified via --task,
+or fargate.yml, or environment variable options. If they are not set, the log
+group /fargate/task/<task> and the stream prefix fargate are assumed.
 
 Return either a specific segment of task logs or tail logs in real-time using
 the --follow option. Logs are prefixed by their log stream name which is in the
-format of fargate/<container-name>/<task-id>.
+format of <stream-prefix>/<container-name>/<task-id>.
 
 --container-name allows you to specifiy the container within the task definition to get logs for
-(defaults to app)
+(defaults to the first container)
 
 Follow will continue to run and return logs until interrupted by Control-C. If
 --follow is passed --end cannot be specified.
 
+Follow keeps track of its position in each log stream, polling idle streams
+less often and backing off when throttled, and picks up the log streams of
+newly started tasks. The position is saved, so that after a disconnect
+--since-last resumes following where the last --follow stopped.
+
 Logs can be returned for specific tasks by passing a task
 ID via the --task flag. Pass --task with a task ID multiple times in order to
 retrieve logs from multiple specific tasks.
@@ -52,9 +67,28 @@ You can filter logs for specific term by passing a filter expression via the
 --filter flag. Pass a single term to search for that term, pass multiple terms
 to search for log messages that include all terms.
 
+--grep only shows messages matching a regular expression, which is applied
+client-side (after --filter) in both one-shot and follow modes. Matches are
+highlighted. -B/--before-context and -A/--after-context also show that many
+lines of the same log stream before and after each match, and --invert shows
+the messages that do not match instead.
+
 --time includes the log timestamp in the output
 
 --no-prefix excludes the log stream prefix from the output
+
+Messages logged as JSON objects can be rendered as selected fields with
+--json-fields (e.g. --json-fields level,msg,trace_id). Nested fields can be
+selected with a dot separated path (e.g. http.status). The severity is
+colorized when its field (e.g. level) is one of the selected fields.
+
+--level only shows structured messages with a severity (read from the level,
+severity, lvl, loglevel or log.level field), either an exact severity (e.g.
+warn) or a severity and everything above it (e.g. error+).
+
+--output ndjson prints each event as a JSON object with its timestamp, log
+stream, task ID and message (parsed if it is JSON), for piping into tools such
+as jq.
 `,
 	Example: `
 fargate task logs
@@ -66,17 +100,24 @@ fargate task logs --task my-task-dev --container-name my-container
 	Run: func(cmd *cobra.Command, args []string) {
 
 		operation := &GetLogsOperation{
-			LogGroupName:      fmt.Sprintf(taskLogGroupFormat, getTaskName()),
 			Filter:            flagTaskLogsFilter,
-			Follow:            flagTaskLogsFollow,
-			Namespace:         flagTaskLogsContainerName,
+			Follow:            flagTaskLogsFollow || flagTaskLogsSinceLast,
+			SinceLast:         flagTaskLogsSinceLast,
+			SaveCheckpoint:    true,
 			IncludeTime:       flagTaskLogsTime,
 			NoLogStreamPrefix: flagTaskLogsNoLogStreamPrefix,
+			JSONFields:        flagTaskLogsJSONFields,
+			Output:            flagTaskLogsOutput,
+			cwl:               CWL.New(sess),
 		}
 
+		operation.AddLogSource("", getTaskLogConfiguration(getTaskName(), flagTaskLogsContainerName))
 		operation.AddTasks(flagTaskLogsTasks)
 		operation.AddStartTime(flagTaskLogsStartTime)
 		operation.AddEndTime(flagTaskLogsEndTime)
+		operation.AddLevelFilter(flagTaskLogsLevel)
+		operation.AddGrep(flagTaskLogsGrep, flagTaskLogsBeforeContext, flagTaskLogsAfterContext, flagTaskLogsInvert)
+		operation.Validate()
 
 		GetLogs(operation)
 	},
@@ -90,7 +131,15 @@ func init() {
 	taskLogsCmd.Flags().StringVar(&flagTaskLogsStartTime, "start", "", "Earliest time to return logs (e.g. -1h, 2018-01-01 09:36:00 EST")
 	taskLogsCmd.Flags().StringVar(&flagTaskLogsEndTime, "end", "", "Latest time to return logs (e.g. 3y, 2021-01-20 12:00:00 EST")
 	taskLogsCmd.Flags().StringSliceVarP(&flagTaskLogsTasks, "task", "t", []string{}, "Show logs from specific task (can be specified multiple times)")
-	taskLogsCmd.Flags().StringVarP(&flagTaskLogsContainerName, "container-name", "n", "app", "name of container in task defintion to get logs for")
+	taskLogsCmd.Flags().StringVarP(&flagTaskLogsContainerName, "container-name", "n", "", "name of container in task defintion to get logs for (defaults to the first container)")
 	taskLogsCmd.PersistentFlags().BoolVarP(&flagTaskLogsTime, "time", "T", false, "append time to logs")
 	taskLogsCmd.PersistentFlags().BoolVarP(&flagTaskLogsNoLogStreamPrefix, "no-prefix", "", false, "don't include log stream prefix in output")
+	taskLogsCmd.Flags().StringSliceVar(&flagTaskLogsJSONFields, "json-fields", []string{}, "Render JSON messages as the given fields (e.g. level,msg,trace_id)")
+	taskLogsCmd.Flags().StringVar(&flagTaskLogsLevel, "level", "", "Only show JSON messages with this severity, or above with + (e.g. error+)")
+	taskLogsCmd.Flags().StringVar(&flagTaskLogsOutput, "output", logOutputText, "Output format (text or ndjson)")
+	taskLogsCmd.Flags().BoolVar(&flagTaskLogsSinceLast, "since-last", false, "Follow logs from where the last --follow of the same logs stopped")
+	taskLogsCmd.Flags().StringVar(&flagTaskLogsGrep, "grep", "", "Only show messages matching a regular expression")
+	taskLogsCmd.Flags().IntVarP(&flagTaskLogsBeforeContext, "before-context", "B", 0, "Show lines of the same stream before each --grep match")
+	taskLogsCmd.Flags().IntVarP(&flagTaskLogsAfterContext, "after-context", "A", 0, "Show lines of the same stream after each --grep match")
+	taskLogsCmd.Flags().BoolVar(&flagTaskLogsInvert, "invert", false, "Only show messages not matching --grep")
 }
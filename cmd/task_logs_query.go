@@ -0,0 +1,9 @@
+package cmd
+
+var taskLogsQueryCmd = newLogsQueryCmd("task", "the log group of the task family", func() string {
+	return getTaskLogConfiguration(getTaskName(), "").LogGroupName
+})
+
+func init() {
+	taskLogsCmd.AddCommand(taskLogsQueryCmd)
+}
@@ -2,8 +2,10 @@ package cmd
 
 import (
 	"fmt"
+	"io/ioutil"
 
 	"github.com/spf13/cobra"
+	"github.com/turnerlabs/fargate/console"
 	ECS "github.com/turnerlabs/fargate/ecs"
 )
 
@@ -13,6 +15,7 @@ var flagTaskRegisterEnvVars []string
 var flagTaskRegisterEnvFile string
 var flagTaskRegisterSecretVars []string
 var flagTaskRegisterSecretFile string
+var flagTaskRegisterFromJSON string
 
 //represents a task register operation
 type taskRegisterOperation struct {
@@ -24,6 +27,7 @@ type taskRegisterOperation struct {
 	ComposeFile string
 	SecretVars  []string
 	SecretFile  string
+	JSONFile    string
 }
 
 var taskRegisterCmd = &cobra.Command{
@@ -40,6 +44,7 @@ var taskRegisterCmd = &cobra.Command{
 			ComposeFile: flagTaskRegisterDockerComposeFile,
 			SecretVars:  flagTaskRegisterSecretVars,
 			SecretFile:  flagTaskRegisterSecretFile,
+			JSONFile:    flagTaskRegisterFromJSON,
 		}
 
 		//valid cli arg combinations
@@ -49,8 +54,8 @@ var taskRegisterCmd = &cobra.Command{
 			len(flagTaskRegisterSecretVars) > 0 ||
 			flagTaskRegisterSecretFile != "")
 
-		if (flagTaskRegisterDockerComposeFile != "" && nonComposeOptions) ||
-			(flagTaskRegisterDockerComposeFile == "" && !nonComposeOptions) {
+		if (flagTaskRegisterDockerComposeFile != "" && (nonComposeOptions || flagTaskRegisterFromJSON != "")) ||
+			(flagTaskRegisterDockerComposeFile == "" && flagTaskRegisterFromJSON == "" && !nonComposeOptions) {
 			cmd.Help()
 			return
 		}
@@ -64,6 +69,8 @@ fargate task register --image 123456789.dkr.ecr.us-east-1.amazonaws.com/my-app:0
 fargate task register --env-file dev.env
 fargate task register --secret-file secrets.env
 fargate task register --file docker-compose.yml
+fargate task register --from-json task-definition.json
+fargate task register --from-json task-definition.json --image 123456789.dkr.ecr.us-east-1.amazonaws.com/my-app:0.2.0 --env FOO=bar
 `,
 }
 
@@ -80,6 +87,8 @@ func init() {
 
 	taskRegisterCmd.Flags().StringVar(&flagTaskRegisterSecretFile, "secret-file", "", "File containing list of secret variables to set, one per line, of the form KEY=valueFrom")
 
+	taskRegisterCmd.Flags().StringVar(&flagTaskRegisterFromJSON, "from-json", "", "JSON file containing a complete task definition (e.g. from task describe --format json). --image, --env and --secret override the first container.")
+
 	taskCmd.AddCommand(taskRegisterCmd)
 }
 
@@ -91,6 +100,11 @@ func registerTask(op taskRegisterOperation) {
 	var secrets []ECS.Secret
 	replaceVars := false
 
+	if op.JSONFile != "" {
+		registerTaskFromJSON(op)
+		return
+	}
+
 	if op.ComposeFile != "" {
 		dockerService := getDockerServiceFromComposeFile(op.ComposeFile)
 		image = dockerService.Image
@@ -111,7 +125,36 @@ func registerTask(op taskRegisterOperation) {
 
 	//update and register new task definition
 	ecs := ECS.New(sess, op.Cluster)
-	newTD := ecs.UpdateTaskDefinitionImageAndEnvVars(op.Task, image, envvars, replaceVars, secrets)
+	newTD, err := ecs.UpdateTaskDefinitionImageAndEnvVars(op.Task, image, envvars, replaceVars, secrets)
+	if err != nil {
+		console.ErrorExit(err, "Could not register ECS task definition")
+	}
+
+	//output new revision
+	fmt.Println(ecs.GetRevisionNumber(newTD))
+}
+
+// registers a task definition from a json file, overriding the image and
+// env vars/secrets if specified
+func registerTaskFromJSON(op taskRegisterOperation) {
+	bits, err := ioutil.ReadFile(op.JSONFile)
+	if err != nil {
+		console.ErrorExit(err, "Could not read %s", op.JSONFile)
+	}
+
+	input, err := ECS.UnmarshalTaskDefinitionInput(bits)
+	if err != nil {
+		console.ErrorExit(err, "Invalid task definition in %s", op.JSONFile)
+	}
+
+	envvars := processEnvVarArgs(op.EnvVars, op.EnvFile)
+	secrets := processSecretVarArgs(op.SecretVars, op.SecretFile)
+
+	ecs := ECS.New(sess, op.Cluster)
+	newTD, err := ecs.RegisterTaskDefinitionFromInput(input, op.Image, envvars, secrets)
+	if err != nil {
+		console.ErrorExit(err, "Could not register ECS task definition")
+	}
 
 	//output new revision
 	fmt.Println(ecs.GetRevisionNumber(newTD))
@@ -3,7 +3,7 @@ package ec2
 import (
 	"github.com/aws/aws-sdk-go/aws"
 	awsec2 "github.com/aws/aws-sdk-go/service/ec2"
-	"github.com/turnerlabs/fargate/console"
+	"github.com/turnerlabs/fargate/awserrors"
 )
 
 type Eni struct {
@@ -12,7 +12,7 @@ type Eni struct {
 	SecurityGroupIds []string
 }
 
-func (ec2 SDKClient) DescribeNetworkInterfaces(eniIds []string) map[string]Eni {
+func (ec2 SDKClient) DescribeNetworkInterfaces(eniIds []string) (map[string]Eni, error) {
 	enis := make(map[string]Eni)
 
 	resp, err := ec2.client.DescribeNetworkInterfaces(
@@ -22,7 +22,7 @@ func (ec2 SDKClient) DescribeNetworkInterfaces(eniIds []string) map[string]Eni {
 	)
 
 	if err != nil {
-		console.ErrorExit(err, "Could not describe network interfaces")
+		return enis, awserrors.Wrap(err)
 	}
 
 	for _, e := range resp.NetworkInterfaces {
@@ -43,5 +43,5 @@ func (ec2 SDKClient) DescribeNetworkInterfaces(eniIds []string) map[string]Eni {
 		}
 	}
 
-	return enis
+	return enis, nil
 }
@@ -6,6 +6,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	awsec2 "github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/turnerlabs/fargate/awserrors"
 )
 
 const (
@@ -31,7 +32,7 @@ func (ec2 SDKClient) GetDefaultSubnetIDs() ([]string, error) {
 	)
 
 	if err != nil {
-		return subnetIDs, fmt.Errorf("could not retrieve default subnet IDs: %v", err)
+		return subnetIDs, fmt.Errorf("could not retrieve default subnet IDs: %w", awserrors.Wrap(err))
 	}
 
 	for _, subnet := range resp.Subnets {
@@ -56,7 +57,7 @@ func (ec2 SDKClient) GetDefaultSecurityGroupID() (string, error) {
 			}
 		}
 
-		return "", fmt.Errorf("could not retrieve default security group ID (%s): %v", defaultSecurityGroupName, err)
+		return "", fmt.Errorf("could not retrieve default security group ID (%s): %w", defaultSecurityGroupName, awserrors.Wrap(err))
 	}
 
 	return aws.StringValue(resp.SecurityGroups[0].GroupId), nil
@@ -72,9 +73,9 @@ func (ec2 SDKClient) GetSubnetVPCID(subnetID string) (string, error) {
 
 	switch {
 	case err != nil:
-		return "", fmt.Errorf("could not find VPC ID for subnet ID %s: %v", subnetID, err)
+		return "", fmt.Errorf("could not find VPC ID for subnet ID %s: %w", subnetID, awserrors.Wrap(err))
 	case len(resp.Subnets) == 0:
-		return "", fmt.Errorf("could not find VPC ID: subnet ID %s not found", subnetID)
+		return "", awserrors.New(awserrors.NotFound, "could not find VPC ID: subnet ID %s not found", subnetID)
 	default:
 		return aws.StringValue(resp.Subnets[0].VpcId), nil
 	}
@@ -90,7 +91,7 @@ func (ec2 SDKClient) CreateDefaultSecurityGroup() (string, error) {
 	)
 
 	if err != nil {
-		return "", fmt.Errorf("could not create default security group (%s): %v", defaultSecurityGroupName, err)
+		return "", fmt.Errorf("could not create default security group (%s): %w", defaultSecurityGroupName, awserrors.Wrap(err))
 	}
 
 	return aws.StringValue(resp.GroupId), nil
@@ -106,5 +107,5 @@ func (ec2 SDKClient) AuthorizeAllSecurityGroupIngress(groupID string) error {
 		},
 	)
 
-	return err
+	return awserrors.Wrap(err)
 }
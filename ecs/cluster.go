@@ -1,8 +1,12 @@
 package ecs
 
 import (
+	"sort"
+	"strings"
+
 	"github.com/aws/aws-sdk-go/aws"
 	awsecs "github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/turnerlabs/fargate/awserrors"
 )
 
 func (ecs *ECS) CreateCluster() (string, error) {
@@ -11,6 +15,78 @@ func (ecs *ECS) CreateCluster() (string, error) {
 	}
 
 	resp, err := ecs.svc.CreateCluster(input)
+	if err != nil {
+		return "", awserrors.Wrap(err)
+	}
+
+	return aws.StringValue(resp.Cluster.ClusterArn), nil
+}
+
+//GetClusterArn returns the arn of the cluster
+func (ecs *ECS) GetClusterArn() (string, error) {
+	resp, err := ecs.svc.DescribeClusters(
+		&awsecs.DescribeClustersInput{
+			Clusters: aws.StringSlice([]string{ecs.ClusterName}),
+		},
+	)
+
+	if err != nil {
+		return "", awserrors.Wrap(err)
+	}
+
+	if len(resp.Clusters) == 0 {
+		return "", awserrors.New(awserrors.NotFound, "Could not find ECS cluster %s", ecs.ClusterName)
+	}
+
+	return aws.StringValue(resp.Clusters[0].ClusterArn), nil
+}
+
+//ClusterExists returns whether the cluster exists and is active
+func (ecs *ECS) ClusterExists() (bool, error) {
+	resp, err := ecs.svc.DescribeClusters(
+		&awsecs.DescribeClustersInput{
+			Clusters: aws.StringSlice([]string{ecs.ClusterName}),
+		},
+	)
+
+	if err != nil {
+		return false, awserrors.Wrap(err)
+	}
+
+	for _, cluster := range resp.Clusters {
+		if aws.StringValue(cluster.Status) == "ACTIVE" {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+//ListClusters returns the names of the clusters in the region
+func (ecs *ECS) ListClusters() ([]string, error) {
+	var clusters []string
+
+	err := ecs.svc.ListClustersPages(
+		&awsecs.ListClustersInput{},
+		func(resp *awsecs.ListClustersOutput, lastPage bool) bool {
+			for _, clusterArn := range resp.ClusterArns {
+				clusters = append(clusters, clusterName(aws.StringValue(clusterArn)))
+			}
+
+			return true
+		},
+	)
+
+	if err != nil {
+		return clusters, awserrors.Wrap(err)
+	}
+
+	sort.Strings(clusters)
+
+	return clusters, nil
+}
 
-	return aws.StringValue(resp.Cluster.ClusterArn), err
+//clusterName returns the name of a cluster from its ARN
+func clusterName(clusterArn string) string {
+	return clusterArn[strings.LastIndex(clusterArn, "/")+1:]
 }
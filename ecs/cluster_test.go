@@ -0,0 +1,16 @@
+package ecs
+
+import "testing"
+
+func TestClusterName(t *testing.T) {
+	tests := map[string]string{
+		"arn:aws:ecs:us-east-1:123456789012:cluster/dev":     "dev",
+		"arn:aws-cn:ecs:cn-north-1:123456789012:cluster/web": "web",
+	}
+
+	for clusterArn, expected := range tests {
+		if name := clusterName(clusterArn); name != expected {
+			t.Errorf("Expected %s, got %s", expected, name)
+		}
+	}
+}
@@ -0,0 +1,79 @@
+package ecs
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awsecs "github.com/aws/aws-sdk-go/service/ecs"
+)
+
+const (
+	DefaultLogStreamPrefix = "fargate"
+
+	awslogsGroupOption        = "awslogs-group"
+	awslogsStreamPrefixOption = "awslogs-stream-prefix"
+)
+
+//LogConfiguration describes where the logs of a container are written
+type LogConfiguration struct {
+	ContainerName string
+	LogGroupName  string
+	StreamPrefix  string
+}
+
+//LogStreamPrefix returns the prefix shared by the log streams of the container
+func (c LogConfiguration) LogStreamPrefix() string {
+	return fmt.Sprintf("%s/%s/", c.StreamPrefix, c.ContainerName)
+}
+
+//LogStreamName returns the name of the log stream of the container in a task
+func (c LogConfiguration) LogStreamName(taskId string) string {
+	return c.LogStreamPrefix() + taskId
+}
+
+//GetLogConfigurations returns the log configuration of each container of a
+//task definition, read from the awslogs-group and awslogs-stream-prefix options
+//of the awslogs log driver. The given log group and the fargate stream prefix
+//are used for options that are not set.
+func GetLogConfigurations(taskDefinition *awsecs.TaskDefinition, defaultLogGroupName string) []LogConfiguration {
+	var logConfigurations []LogConfiguration
+
+	for _, container := range taskDefinition.ContainerDefinitions {
+		logConfiguration := LogConfiguration{
+			ContainerName: aws.StringValue(container.Name),
+			LogGroupName:  defaultLogGroupName,
+			StreamPrefix:  DefaultLogStreamPrefix,
+		}
+
+		if lc := container.LogConfiguration; lc != nil && aws.StringValue(lc.LogDriver) == awsecs.LogDriverAwslogs {
+			if group := aws.StringValue(lc.Options[awslogsGroupOption]); group != "" {
+				logConfiguration.LogGroupName = group
+			}
+
+			if prefix := aws.StringValue(lc.Options[awslogsStreamPrefixOption]); prefix != "" {
+				logConfiguration.StreamPrefix = prefix
+			}
+		}
+
+		logConfigurations = append(logConfigurations, logConfiguration)
+	}
+
+	return logConfigurations
+}
+
+//GetContainerLogConfiguration returns the log configuration of a container of a
+//task definition, or of its first container if no name is given. If the
+//container is not found, the conventional log configuration is returned.
+func GetContainerLogConfiguration(taskDefinition *awsecs.TaskDefinition, containerName, defaultLogGroupName string) LogConfiguration {
+	for _, logConfiguration := range GetLogConfigurations(taskDefinition, defaultLogGroupName) {
+		if containerName == "" || logConfiguration.ContainerName == containerName {
+			return logConfiguration
+		}
+	}
+
+	return LogConfiguration{
+		ContainerName: containerName,
+		LogGroupName:  defaultLogGroupName,
+		StreamPrefix:  DefaultLogStreamPrefix,
+	}
+}
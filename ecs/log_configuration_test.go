@@ -0,0 +1,65 @@
+package ecs
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awsecs "github.com/aws/aws-sdk-go/service/ecs"
+)
+
+func TestGetLogConfigurations(t *testing.T) {
+	taskDefinition := &awsecs.TaskDefinition{
+		ContainerDefinitions: []*awsecs.ContainerDefinition{
+			&awsecs.ContainerDefinition{
+				Name: aws.String("app"),
+				LogConfiguration: &awsecs.LogConfiguration{
+					LogDriver: aws.String(awsecs.LogDriverAwslogs),
+					Options: aws.StringMap(map[string]string{
+						"awslogs-group":         "/ecs/my-app",
+						"awslogs-stream-prefix": "ecs",
+					}),
+				},
+			},
+			&awsecs.ContainerDefinition{
+				Name: aws.String("nginx"),
+				LogConfiguration: &awsecs.LogConfiguration{
+					LogDriver: aws.String(awsecs.LogDriverAwslogs),
+					Options:   aws.StringMap(map[string]string{"awslogs-group": "/ecs/nginx"}),
+				},
+			},
+			&awsecs.ContainerDefinition{
+				Name: aws.String("sidecar"),
+			},
+		},
+	}
+
+	expected := []LogConfiguration{
+		LogConfiguration{ContainerName: "app", LogGroupName: "/ecs/my-app", StreamPrefix: "ecs"},
+		LogConfiguration{ContainerName: "nginx", LogGroupName: "/ecs/nginx", StreamPrefix: "fargate"},
+		LogConfiguration{ContainerName: "sidecar", LogGroupName: "/fargate/service/my-app", StreamPrefix: "fargate"},
+	}
+
+	got := GetLogConfigurations(taskDefinition, "/fargate/service/my-app")
+
+	if len(got) != len(expected) {
+		t.Fatalf("Expected %v, got %v", expected, got)
+	}
+
+	for i := range expected {
+		if got[i] != expected[i] {
+			t.Errorf("Expected %v, got %v", expected[i], got[i])
+		}
+	}
+
+	if name := got[0].LogStreamName("abc"); name != "ecs/app/abc" {
+		t.Errorf("Expected ecs/app/abc, got %s", name)
+	}
+
+	if c := GetContainerLogConfiguration(taskDefinition, "", "/default"); c.ContainerName != "app" {
+		t.Errorf("Expected the first container, got %s", c.ContainerName)
+	}
+
+	if c := GetContainerLogConfiguration(taskDefinition, "missing", "/default"); c.LogGroupName != "/default" || c.StreamPrefix != "fargate" {
+		t.Errorf("Expected the conventional configuration, got %v", c)
+	}
+}
@@ -1,18 +1,26 @@
 package ecs
 
+//go:generate mockgen -package sdk -source ../vendor/github.com/aws/aws-sdk-go/service/ecs/ecsiface/interface.go -destination=mock/sdk/ecsiface.go github.com/aws/aws-sdk-go/service/ecs/ecsiface ECSAPI
+
 import (
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/aws-sdk-go/service/ecs/ecsiface"
 )
 
 type ECS struct {
-	svc         *ecs.ECS
+	svc         ecsiface.ECSAPI
 	ClusterName string
 }
 
 func New(sess *session.Session, clusterName string) ECS {
+	return NewWithClient(ecs.New(sess), clusterName)
+}
+
+// NewWithClient returns an ECS for the cluster that calls the given client.
+func NewWithClient(client ecsiface.ECSAPI, clusterName string) ECS {
 	return ECS{
 		ClusterName: clusterName,
-		svc:         ecs.New(sess),
+		svc:         client,
 	}
 }
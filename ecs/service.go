@@ -1,12 +1,11 @@
 package ecs
 
 import (
-	"fmt"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/aws/awserr"
 	awsecs "github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/turnerlabs/fargate/awserrors"
 	"github.com/turnerlabs/fargate/console"
 )
 
@@ -29,6 +28,7 @@ type ServiceRegistry struct {
 }
 
 type Service struct {
+	AssignPublicIp    string
 	Cluster           string
 	Cpu               string
 	Deployments       []Deployment
@@ -73,7 +73,7 @@ func (s *Service) AddDeployment(d Deployment) {
 	s.Deployments = append(s.Deployments, d)
 }
 
-func (ecs *ECS) CreateService(input *CreateServiceInput) {
+func (ecs *ECS) CreateService(input *CreateServiceInput) error {
 	console.Debug("Creating ECS service")
 
 	createServiceInput := &awsecs.CreateServiceInput{
@@ -106,30 +106,55 @@ func (ecs *ECS) CreateService(input *CreateServiceInput) {
 	_, err := ecs.svc.CreateService(createServiceInput)
 
 	if err != nil {
-		console.ErrorExit(err, "Couldn't create ECS service")
+		return awserrors.Wrap(err)
 	}
 
 	console.Debug("Created ECS service [%s]", input.Name)
 
-	return
+	return nil
 }
 
-func (ecs *ECS) DescribeService(serviceName string) Service {
-	services := ecs.DescribeServices([]string{serviceName})
+func (ecs *ECS) DescribeService(serviceName string) (Service, error) {
+	services, err := ecs.DescribeServices([]string{serviceName})
+	if err != nil {
+		return Service{}, err
+	}
 
 	if len(services) == 0 {
-		console.ErrorExit(fmt.Errorf("Could not find %s", serviceName), "Could not describe ECS service")
+		return Service{}, awserrors.New(awserrors.NotFound, "Could not find %s", serviceName)
 	}
 
-	return services[0]
+	return services[0], nil
 }
 
-func (ecs *ECS) GetDesiredCount(serviceName string) int64 {
-	service := ecs.DescribeService(serviceName)
-	return service.DesiredCount
+//ServiceExists returns whether a service exists in the cluster and is active
+func (ecs *ECS) ServiceExists(serviceName string) (bool, error) {
+	resp, err := ecs.svc.DescribeServices(
+		&awsecs.DescribeServicesInput{
+			Cluster:  aws.String(ecs.ClusterName),
+			Services: aws.StringSlice([]string{serviceName}),
+		},
+	)
+
+	if err != nil {
+		return false, awserrors.Wrap(err)
+	}
+
+	for _, service := range resp.Services {
+		if aws.StringValue(service.Status) == "ACTIVE" {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+func (ecs *ECS) GetDesiredCount(serviceName string) (int64, error) {
+	service, err := ecs.DescribeService(serviceName)
+	return service.DesiredCount, err
 }
 
-func (ecs *ECS) SetDesiredCount(serviceName string, desiredCount int64) {
+func (ecs *ECS) SetDesiredCount(serviceName string, desiredCount int64) error {
 	_, err := ecs.svc.UpdateService(
 		&awsecs.UpdateServiceInput{
 			Cluster:      aws.String(ecs.ClusterName),
@@ -138,12 +163,10 @@ func (ecs *ECS) SetDesiredCount(serviceName string, desiredCount int64) {
 		},
 	)
 
-	if err != nil {
-		console.ErrorExit(err, "Could not scale ECS service")
-	}
+	return awserrors.Wrap(err)
 }
 
-func (ecs *ECS) DestroyService(serviceName string) {
+func (ecs *ECS) DestroyService(serviceName string) error {
 	_, err := ecs.svc.DeleteService(
 		&awsecs.DeleteServiceInput{
 			Cluster: aws.String(ecs.ClusterName),
@@ -151,12 +174,10 @@ func (ecs *ECS) DestroyService(serviceName string) {
 		},
 	)
 
-	if err != nil {
-		console.ErrorExit(err, "Could not destroy ECS service")
-	}
+	return awserrors.Wrap(err)
 }
 
-func (ecs *ECS) ListServices() []Service {
+func (ecs *ECS) ListServices() ([]Service, error) {
 	var services []Service
 	var serviceArnBatches [][]string
 
@@ -176,21 +197,22 @@ func (ecs *ECS) ListServices() []Service {
 	)
 
 	if err != nil {
-		console.ErrorExit(err, "Could not list ECS services")
+		return services, awserrors.Wrap(err)
 	}
 
-	if len(serviceArnBatches) > 0 {
-		for _, serviceArnBatch := range serviceArnBatches {
-			for _, service := range ecs.DescribeServices(serviceArnBatch) {
-				services = append(services, service)
-			}
+	for _, serviceArnBatch := range serviceArnBatches {
+		batch, err := ecs.DescribeServices(serviceArnBatch)
+		if err != nil {
+			return services, err
 		}
+
+		services = append(services, batch...)
 	}
 
-	return services
+	return services, nil
 }
 
-func (ecs *ECS) DescribeServices(serviceArns []string) []Service {
+func (ecs *ECS) DescribeServices(serviceArns []string) ([]Service, error) {
 	var services []Service
 
 	resp, err := ecs.svc.DescribeServices(
@@ -201,18 +223,21 @@ func (ecs *ECS) DescribeServices(serviceArns []string) []Service {
 	)
 
 	if err != nil {
-		console.ErrorExit(err, "Could not describe ECS services")
+		return services, awserrors.Wrap(err)
 	}
 
 	for _, service := range resp.Services {
 		var securityGroupIds, subnetIds []*string
+		var assignPublicIp string
 
 		if config := service.NetworkConfiguration.AwsvpcConfiguration; config != nil {
 			securityGroupIds = config.SecurityGroups
 			subnetIds = config.Subnets
+			assignPublicIp = aws.StringValue(config.AssignPublicIp)
 		}
 
 		s := Service{
+			AssignPublicIp:    assignPublicIp,
 			DesiredCount:      aws.Int64Value(service.DesiredCount),
 			Name:              aws.StringValue(service.ServiceName),
 			PendingCount:      aws.Int64Value(service.PendingCount),
@@ -223,7 +248,12 @@ func (ecs *ECS) DescribeServices(serviceArns []string) []Service {
 			TaskDefinitionArn: aws.StringValue(service.TaskDefinition),
 		}
 
-		taskDefinition := ecs.DescribeTaskDefinition(aws.StringValue(service.TaskDefinition)).TaskDefinition
+		dtd, err := ecs.DescribeTaskDefinition(aws.StringValue(service.TaskDefinition))
+		if err != nil {
+			return services, err
+		}
+
+		taskDefinition := dtd.TaskDefinition
 
 		s.Cpu = aws.StringValue(taskDefinition.Cpu)
 		s.Memory = aws.StringValue(taskDefinition.Memory)
@@ -288,8 +318,12 @@ func (ecs *ECS) DescribeServices(serviceArns []string) []Service {
 				Id:           ecs.GetRevisionNumber(aws.StringValue(d.TaskDefinition)),
 			}
 
-			deploymentTaskDefinition := ecs.DescribeTaskDefinition(aws.StringValue(d.TaskDefinition)).TaskDefinition
-			deployment.Image = aws.StringValue(deploymentTaskDefinition.ContainerDefinitions[0].Image)
+			deploymentTaskDefinition, err := ecs.DescribeTaskDefinition(aws.StringValue(d.TaskDefinition))
+			if err != nil {
+				return services, err
+			}
+
+			deployment.Image = aws.StringValue(deploymentTaskDefinition.TaskDefinition.ContainerDefinitions[0].Image)
 
 			s.AddDeployment(deployment)
 		}
@@ -297,10 +331,10 @@ func (ecs *ECS) DescribeServices(serviceArns []string) []Service {
 		services = append(services, s)
 	}
 
-	return services
+	return services, nil
 }
 
-func (ecs *ECS) UpdateServiceTaskDefinition(serviceName, taskDefinitionArn string) {
+func (ecs *ECS) UpdateServiceTaskDefinition(serviceName, taskDefinitionArn string) error {
 	_, err := ecs.svc.UpdateService(
 		&awsecs.UpdateServiceInput{
 			Cluster:        aws.String(ecs.ClusterName),
@@ -309,12 +343,10 @@ func (ecs *ECS) UpdateServiceTaskDefinition(serviceName, taskDefinitionArn strin
 		},
 	)
 
-	if err != nil {
-		console.ErrorExit(err, "Could not update ECS service task definition")
-	}
+	return awserrors.Wrap(err)
 }
 
-func (ecs *ECS) RestartService(serviceName string) {
+func (ecs *ECS) RestartService(serviceName string) error {
 	_, err := ecs.svc.UpdateService(
 		&awsecs.UpdateServiceInput{
 			Cluster:            aws.String(ecs.ClusterName),
@@ -323,21 +355,12 @@ func (ecs *ECS) RestartService(serviceName string) {
 		},
 	)
 
-	if err != nil {
-		if aerr, ok := err.(awserr.Error); ok {
-			switch aerr.Code() {
-			case "ServiceNotFoundException":
-				console.IssueExit("Service %s not found", serviceName)
-			default:
-				console.ErrorExit(err, "Could not restart service")
-			}
-		}
-
-		console.ErrorExit(err, "Could not restart service")
-	}
+	return awserrors.Wrap(err)
 }
 
-func (ecs *ECS) WaitUntilServiceStable(serviceName string) {
+//WaitForServiceStable waits for a service to reach a steady state, returning an
+//error if it does not
+func (ecs *ECS) WaitForServiceStable(serviceName string) error {
 	err := ecs.svc.WaitUntilServicesStable(
 		&awsecs.DescribeServicesInput{
 			Cluster:  aws.String(ecs.ClusterName),
@@ -345,7 +368,5 @@ func (ecs *ECS) WaitUntilServiceStable(serviceName string) {
 		},
 	)
 
-	if err != nil {
-		console.ErrorExit(err, "Could not wait for ECS service to reach a steady state")
-	}
+	return awserrors.Wrap(err)
 }
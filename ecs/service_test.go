@@ -0,0 +1,129 @@
+package ecs
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	awsecs "github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/golang/mock/gomock"
+	"github.com/turnerlabs/fargate/awserrors"
+	"github.com/turnerlabs/fargate/ecs/mock/sdk"
+)
+
+func TestDescribeService(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	taskDefinitionArn := "arn:aws:ecs:us-east-1:123456789012:task-definition/describe-service:3"
+
+	mockECSClient := sdk.NewMockECSAPI(mockCtrl)
+	ecs := NewWithClient(mockECSClient, "my-cluster")
+
+	mockECSClient.EXPECT().DescribeServices(
+		&awsecs.DescribeServicesInput{
+			Cluster:  aws.String("my-cluster"),
+			Services: aws.StringSlice([]string{"web"}),
+		},
+	).Return(
+		&awsecs.DescribeServicesOutput{
+			Services: []*awsecs.Service{
+				&awsecs.Service{
+					ServiceName:          aws.String("web"),
+					DesiredCount:         aws.Int64(2),
+					Status:               aws.String("ACTIVE"),
+					TaskDefinition:       aws.String(taskDefinitionArn),
+					NetworkConfiguration: &awsecs.NetworkConfiguration{},
+				},
+			},
+		},
+		nil,
+	)
+
+	//task definitions are cached across tests
+	mockECSClient.EXPECT().DescribeTaskDefinition(gomock.Any()).Return(
+		&awsecs.DescribeTaskDefinitionOutput{
+			TaskDefinition: &awsecs.TaskDefinition{
+				Cpu:    aws.String("256"),
+				Memory: aws.String("512"),
+				ContainerDefinitions: []*awsecs.ContainerDefinition{
+					&awsecs.ContainerDefinition{Image: aws.String("nginx:1.25")},
+				},
+			},
+		},
+		nil,
+	).AnyTimes()
+
+	service, err := ecs.DescribeService("web")
+
+	if err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+
+	if service.DesiredCount != 2 {
+		t.Errorf("Expected desired count 2, got %d", service.DesiredCount)
+	}
+
+	if service.Image != "nginx:1.25" {
+		t.Errorf("Expected image nginx:1.25, got %s", service.Image)
+	}
+
+	if service.TaskDefinitionArn != taskDefinitionArn {
+		t.Errorf("Expected %s, got %s", taskDefinitionArn, service.TaskDefinitionArn)
+	}
+}
+
+func TestDescribeServiceNotFound(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mockECSClient := sdk.NewMockECSAPI(mockCtrl)
+	ecs := NewWithClient(mockECSClient, "my-cluster")
+
+	mockECSClient.EXPECT().DescribeServices(gomock.Any()).Return(&awsecs.DescribeServicesOutput{}, nil)
+
+	_, err := ecs.DescribeService("web")
+
+	if !awserrors.IsNotFound(err) {
+		t.Errorf("Expected a not found error, got %v", err)
+	}
+}
+
+func TestSetDesiredCount(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mockECSClient := sdk.NewMockECSAPI(mockCtrl)
+	ecs := NewWithClient(mockECSClient, "my-cluster")
+
+	mockECSClient.EXPECT().UpdateService(
+		&awsecs.UpdateServiceInput{
+			Cluster:      aws.String("my-cluster"),
+			Service:      aws.String("web"),
+			DesiredCount: aws.Int64(5),
+		},
+	).Return(&awsecs.UpdateServiceOutput{}, nil)
+
+	if err := ecs.SetDesiredCount("web", 5); err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+}
+
+func TestSetDesiredCountError(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mockECSClient := sdk.NewMockECSAPI(mockCtrl)
+	ecs := NewWithClient(mockECSClient, "my-cluster")
+
+	mockECSClient.EXPECT().UpdateService(gomock.Any()).Return(
+		nil,
+		awserr.New(awsecs.ErrCodeServiceNotFoundException, "Service not found.", nil),
+	)
+
+	err := ecs.SetDesiredCount("web", 5)
+
+	if !awserrors.IsNotFound(err) {
+		t.Errorf("Expected a not found error, got %v", err)
+	}
+}
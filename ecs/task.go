@@ -8,7 +8,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	awsecs "github.com/aws/aws-sdk-go/service/ecs"
-	"github.com/turnerlabs/fargate/console"
+	"github.com/turnerlabs/fargate/awserrors"
 )
 
 const (
@@ -17,23 +17,37 @@ const (
 	startedByFormat           = "fargate:%s"
 	taskGroupStartedByPattern = "fargate:(.*)"
 	eniAttachmentType         = "ElasticNetworkInterface"
+	taskFailureMissing        = "MISSING"
 )
 
 type Task struct {
-	Cpu              string
-	CreatedAt        time.Time
-	DeploymentId     string
-	DesiredStatus    string
-	EniId            string
-	EnvVars          []EnvVar
-	Image            string
-	LastStatus       string
-	Memory           string
-	SecurityGroupIds []string
-	StartedBy        string
-	SubnetId         string
-	TaskId           string
-	TaskRole         string
+	Containers        []Container
+	Cpu               string
+	CreatedAt         time.Time
+	DeploymentId      string
+	DesiredStatus     string
+	EniId             string
+	EnvVars           []EnvVar
+	Image             string
+	LastStatus        string
+	Memory            string
+	SecurityGroupIds  []string
+	StartedAt         time.Time
+	StartedBy         string
+	StopCode          string
+	StoppedAt         time.Time
+	StoppedReason     string
+	SubnetId          string
+	TaskDefinitionArn string
+	TaskId            string
+	TaskRole          string
+}
+
+type Container struct {
+	ExitCode   *int64
+	LastStatus string
+	Name       string
+	Reason     string
 }
 
 func (t *Task) RunningFor() time.Duration {
@@ -46,38 +60,118 @@ type TaskGroup struct {
 }
 
 type RunTaskInput struct {
-	ClusterName       string
-	Count             int64
-	SecurityGroupIds  []string
-	SubnetIds         []string
-	TaskDefinitionArn string
-	TaskName          string
+	AssignPublicIp           string
+	CapacityProviderStrategy []CapacityProviderStrategyItem
+	ClusterName              string
+	Count                    int64
+	Group                    string
+	LaunchType               string
+	Overrides                *awsecs.TaskOverride
+	PlatformVersion          string
+	SecurityGroupIds         []string
+	StartedBy                string
+	SubnetIds                []string
+	TaskDefinitionArn        string
+	TaskName                 string
 }
 
-func (ecs *ECS) RunTask(i *RunTaskInput) {
-	_, err := ecs.svc.RunTask(
-		&awsecs.RunTaskInput{
-			Cluster:        aws.String(i.ClusterName),
-			Count:          aws.Int64(i.Count),
-			TaskDefinition: aws.String(i.TaskDefinitionArn),
-			LaunchType:     aws.String(awsecs.CompatibilityFargate),
-			StartedBy:      aws.String(fmt.Sprintf(startedByFormat, i.TaskName)),
-			NetworkConfiguration: &awsecs.NetworkConfiguration{
-				AwsvpcConfiguration: &awsecs.AwsVpcConfiguration{
-					AssignPublicIp: aws.String(awsecs.AssignPublicIpEnabled),
-					Subnets:        aws.StringSlice(i.SubnetIds),
-					SecurityGroups: aws.StringSlice(i.SecurityGroupIds),
-				},
+//CapacityProviderStrategyItem is a capacity provider of the strategy to run
+//tasks with
+type CapacityProviderStrategyItem struct {
+	CapacityProvider string
+	Base             int64
+	Weight           int64
+}
+
+//RunTask runs a task and returns the ids of the started tasks. Tasks run with
+//the capacity provider strategy or launch type of the input, on Fargate if
+//neither is set. Tasks are started by fargate:<task name> unless StartedBy is
+//set. The awsvpc network configuration is only sent when subnets are given (EC2
+//tasks in bridge or host mode have none), and tasks get a public IP address
+//unless AssignPublicIp is set. If some of the tasks could not be started, the
+//ids of the others are returned along with the error.
+func (ecs *ECS) RunTask(i *RunTaskInput) ([]string, error) {
+	var taskIds []string
+
+	startedBy := i.StartedBy
+	if startedBy == "" {
+		startedBy = fmt.Sprintf(startedByFormat, i.TaskName)
+	}
+
+	input := &awsecs.RunTaskInput{
+		Cluster:        aws.String(i.ClusterName),
+		Count:          aws.Int64(i.Count),
+		TaskDefinition: aws.String(i.TaskDefinitionArn),
+		StartedBy:      aws.String(startedBy),
+		Overrides:      i.Overrides,
+	}
+
+	if len(i.SubnetIds) > 0 {
+		assignPublicIp := i.AssignPublicIp
+		if assignPublicIp == "" {
+			assignPublicIp = awsecs.AssignPublicIpEnabled
+		}
+
+		input.NetworkConfiguration = &awsecs.NetworkConfiguration{
+			AwsvpcConfiguration: &awsecs.AwsVpcConfiguration{
+				AssignPublicIp: aws.String(assignPublicIp),
+				Subnets:        aws.StringSlice(i.SubnetIds),
+				SecurityGroups: aws.StringSlice(i.SecurityGroupIds),
 			},
-		},
-	)
+		}
+	}
+
+	for _, item := range i.CapacityProviderStrategy {
+		input.CapacityProviderStrategy = append(input.CapacityProviderStrategy,
+			&awsecs.CapacityProviderStrategyItem{
+				CapacityProvider: aws.String(item.CapacityProvider),
+				Base:             aws.Int64(item.Base),
+				Weight:           aws.Int64(item.Weight),
+			},
+		)
+	}
+
+	if len(input.CapacityProviderStrategy) == 0 {
+		launchType := i.LaunchType
+		if launchType == "" {
+			launchType = awsecs.CompatibilityFargate
+		}
+
+		input.SetLaunchType(launchType)
+	}
+
+	if i.PlatformVersion != "" {
+		input.SetPlatformVersion(i.PlatformVersion)
+	}
+
+	if i.Group != "" {
+		input.SetGroup(i.Group)
+	}
+
+	resp, err := ecs.svc.RunTask(input)
 
 	if err != nil {
-		console.ErrorExit(err, "Could not run ECS task")
+		return taskIds, awserrors.Wrap(err)
+	}
+
+	for _, task := range resp.Tasks {
+		taskIds = append(taskIds, getTaskId(aws.StringValue(task.TaskArn)))
 	}
+
+	if len(resp.Failures) > 0 {
+		var failures []string
+
+		for _, failure := range resp.Failures {
+			failures = append(failures, strings.TrimSpace(aws.StringValue(failure.Reason)+" "+aws.StringValue(failure.Detail)))
+		}
+
+		return taskIds, awserrors.New(awserrors.Unknown, "%s", strings.Join(failures, ", "))
+	}
+
+	return taskIds, nil
 }
 
-func (ecs *ECS) DescribeTasksForService(serviceName string) []Task {
+func (ecs *ECS) DescribeTasksForService(serviceName string) ([]Task, error) {
 	return ecs.listTasks(
 		&awsecs.ListTasksInput{
 			Cluster:     aws.String(ecs.ClusterName),
@@ -87,7 +181,46 @@ func (ecs *ECS) DescribeTasksForService(serviceName string) []Task {
 	)
 }
 
-func (ecs *ECS) DescribeTasksForTaskGroup(taskGroupName string) []Task {
+//DescribeStoppedTasksForService returns the recently stopped tasks of a service
+//(ECS only retains stopped tasks for a short time)
+func (ecs *ECS) DescribeStoppedTasksForService(serviceName string) ([]Task, error) {
+	return ecs.listTasks(
+		&awsecs.ListTasksInput{
+			Cluster:       aws.String(ecs.ClusterName),
+			DesiredStatus: aws.String(awsecs.DesiredStatusStopped),
+			ServiceName:   aws.String(serviceName),
+		},
+	)
+}
+
+//ListTaskIdsForService returns the ids of the tasks of a service with the given
+//desired status, without describing them
+func (ecs *ECS) ListTaskIdsForService(serviceName, desiredStatus string) ([]string, error) {
+	var taskIds []string
+
+	err := ecs.svc.ListTasksPages(
+		&awsecs.ListTasksInput{
+			Cluster:       aws.String(ecs.ClusterName),
+			DesiredStatus: aws.String(desiredStatus),
+			ServiceName:   aws.String(serviceName),
+		},
+		func(resp *awsecs.ListTasksOutput, lastPage bool) bool {
+			for _, taskArn := range resp.TaskArns {
+				taskIds = append(taskIds, getTaskId(aws.StringValue(taskArn)))
+			}
+
+			return true
+		},
+	)
+
+	if err != nil {
+		return taskIds, awserrors.Wrap(err)
+	}
+
+	return taskIds, nil
+}
+
+func (ecs *ECS) DescribeTasksForTaskGroup(taskGroupName string) ([]Task, error) {
 	return ecs.listTasks(
 		&awsecs.ListTasksInput{
 			StartedBy: aws.String(fmt.Sprintf(startedByFormat, taskGroupName)),
@@ -96,7 +229,19 @@ func (ecs *ECS) DescribeTasksForTaskGroup(taskGroupName string) []Task {
 	)
 }
 
-func (ecs *ECS) ListTaskGroups() []*TaskGroup {
+//DescribeRunningTasksStartedBy returns the running tasks that were started by
+//the given value
+func (ecs *ECS) DescribeRunningTasksStartedBy(startedBy string) ([]Task, error) {
+	return ecs.listTasks(
+		&awsecs.ListTasksInput{
+			Cluster:       aws.String(ecs.ClusterName),
+			DesiredStatus: aws.String(awsecs.DesiredStatusRunning),
+			StartedBy:     aws.String(startedBy),
+		},
+	)
+}
+
+func (ecs *ECS) ListTaskGroups() ([]*TaskGroup, error) {
 	var taskGroups []*TaskGroup
 
 	taskGroupStartedByRegexp := regexp.MustCompile(taskGroupStartedByPattern)
@@ -105,8 +250,13 @@ func (ecs *ECS) ListTaskGroups() []*TaskGroup {
 		Cluster: aws.String(ecs.ClusterName),
 	}
 
+	tasks, err := ecs.listTasks(input)
+	if err != nil {
+		return taskGroups, err
+	}
+
 OUTER:
-	for _, task := range ecs.listTasks(input) {
+	for _, task := range tasks {
 		matches := taskGroupStartedByRegexp.FindStringSubmatch(task.StartedBy)
 
 		if len(matches) == 2 {
@@ -129,16 +279,20 @@ OUTER:
 		}
 	}
 
-	return taskGroups
+	return taskGroups, nil
 }
 
-func (ecs *ECS) StopTasks(taskIds []string) {
+func (ecs *ECS) StopTasks(taskIds []string) error {
 	for _, taskId := range taskIds {
-		ecs.StopTask(taskId)
+		if err := ecs.StopTask(taskId); err != nil {
+			return err
+		}
 	}
+
+	return nil
 }
 
-func (ecs *ECS) StopTask(taskId string) {
+func (ecs *ECS) StopTask(taskId string) error {
 	_, err := ecs.svc.StopTask(
 		&awsecs.StopTaskInput{
 			Cluster: aws.String(ecs.ClusterName),
@@ -146,12 +300,10 @@ func (ecs *ECS) StopTask(taskId string) {
 		},
 	)
 
-	if err != nil {
-		console.ErrorExit(err, "Could not stop ECS task")
-	}
+	return awserrors.Wrap(err)
 }
 
-func (ecs *ECS) listTasks(input *awsecs.ListTasksInput) []Task {
+func (ecs *ECS) listTasks(input *awsecs.ListTasksInput) ([]Task, error) {
 	var tasks []Task
 	var taskArnBatches [][]string
 
@@ -167,25 +319,31 @@ func (ecs *ECS) listTasks(input *awsecs.ListTasksInput) []Task {
 	)
 
 	if err != nil {
-		console.ErrorExit(err, "Could not list ECS tasks")
+		return tasks, awserrors.Wrap(err)
 	}
 
-	if len(taskArnBatches) > 0 {
-		for _, taskArnBatch := range taskArnBatches {
-			for _, task := range ecs.DescribeTasks(taskArnBatch) {
-				tasks = append(tasks, task)
-			}
+	for _, taskArnBatch := range taskArnBatches {
+		batch, err := ecs.DescribeTasks(taskArnBatch)
+
+		//tasks can expire between being listed and described
+		if err != nil && !awserrors.IsNotFound(err) {
+			return tasks, err
 		}
+
+		tasks = append(tasks, batch...)
 	}
 
-	return tasks
+	return tasks, nil
 }
 
-func (ecs *ECS) DescribeTasks(taskIds []string) []Task {
+//DescribeTasks describes tasks by id or ARN. If some of the tasks could not be
+//described, the others are returned along with the error, which is a not found
+//error if the tasks do not exist.
+func (ecs *ECS) DescribeTasks(taskIds []string) ([]Task, error) {
 	var tasks []Task
 
 	if len(taskIds) == 0 {
-		return tasks
+		return tasks, nil
 	}
 
 	resp, err := ecs.svc.DescribeTasks(
@@ -196,26 +354,43 @@ func (ecs *ECS) DescribeTasks(taskIds []string) []Task {
 	)
 
 	if err != nil {
-		console.ErrorExit(err, "Could not describe ECS tasks")
+		return tasks, awserrors.Wrap(err)
 	}
 
 	for _, t := range resp.Tasks {
-		taskArn := aws.StringValue(t.TaskArn)
-		contents := strings.Split(taskArn, "/")
-		taskID := contents[len(contents)-1]
-
 		task := Task{
-			Cpu:           aws.StringValue(t.Cpu),
-			CreatedAt:     aws.TimeValue(t.CreatedAt),
-			DeploymentId:  ecs.GetRevisionNumber(aws.StringValue(t.TaskDefinitionArn)),
-			DesiredStatus: aws.StringValue(t.DesiredStatus),
-			LastStatus:    aws.StringValue(t.LastStatus),
-			Memory:        aws.StringValue(t.Memory),
-			TaskId:        taskID,
-			StartedBy:     aws.StringValue(t.StartedBy),
+			Cpu:               aws.StringValue(t.Cpu),
+			CreatedAt:         aws.TimeValue(t.CreatedAt),
+			DeploymentId:      ecs.GetRevisionNumber(aws.StringValue(t.TaskDefinitionArn)),
+			DesiredStatus:     aws.StringValue(t.DesiredStatus),
+			LastStatus:        aws.StringValue(t.LastStatus),
+			Memory:            aws.StringValue(t.Memory),
+			TaskId:            getTaskId(aws.StringValue(t.TaskArn)),
+			StartedAt:         aws.TimeValue(t.StartedAt),
+			StartedBy:         aws.StringValue(t.StartedBy),
+			StopCode:          aws.StringValue(t.StopCode),
+			StoppedAt:         aws.TimeValue(t.StoppedAt),
+			StoppedReason:     aws.StringValue(t.StoppedReason),
+			TaskDefinitionArn: aws.StringValue(t.TaskDefinitionArn),
+		}
+
+		for _, c := range t.Containers {
+			task.Containers = append(
+				task.Containers,
+				Container{
+					ExitCode:   c.ExitCode,
+					LastStatus: aws.StringValue(c.LastStatus),
+					Name:       aws.StringValue(c.Name),
+					Reason:     aws.StringValue(c.Reason),
+				},
+			)
+		}
+
+		taskDefinition, err := ecs.DescribeTaskDefinition(aws.StringValue(t.TaskDefinitionArn))
+		if err != nil {
+			return tasks, err
 		}
 
-		taskDefinition := ecs.DescribeTaskDefinition(aws.StringValue(t.TaskDefinitionArn))
 		task.Image = aws.StringValue(taskDefinition.TaskDefinition.ContainerDefinitions[0].Image)
 		task.TaskRole = aws.StringValue(taskDefinition.TaskDefinition.TaskRoleArn)
 
@@ -239,7 +414,28 @@ func (ecs *ECS) DescribeTasks(taskIds []string) []Task {
 		tasks = append(tasks, task)
 	}
 
-	return tasks
+	if len(resp.Failures) > 0 {
+		kind := awserrors.NotFound
+
+		var failures []string
+
+		for _, failure := range resp.Failures {
+			if aws.StringValue(failure.Reason) != taskFailureMissing {
+				kind = awserrors.Unknown
+			}
+
+			failures = append(failures, fmt.Sprintf("%s: %s", getTaskId(aws.StringValue(failure.Arn)), strings.TrimSpace(aws.StringValue(failure.Reason)+" "+aws.StringValue(failure.Detail))))
+		}
+
+		return tasks, awserrors.New(kind, "could not describe tasks (%s)", strings.Join(failures, ", "))
+	}
+
+	return tasks, nil
+}
+
+func getTaskId(taskArn string) string {
+	contents := strings.Split(taskArn, "/")
+	return contents[len(contents)-1]
 }
 
 func determineENIDetails(t *awsecs.Task) (bool, string, string) {
@@ -5,15 +5,21 @@ import (
 	"sort"
 	"strconv"
 	"strings"
+	"sync"
 
 	"github.com/aws/aws-sdk-go/aws"
 	awsecs "github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/turnerlabs/fargate/awserrors"
 	"github.com/turnerlabs/fargate/console"
 )
 
 const logStreamPrefix = "fargate"
 
-var taskDefinitionCache = make(map[string]*awsecs.DescribeTaskDefinitionOutput)
+//task definitions are described concurrently, e.g. by events run --follow
+var (
+	taskDefinitionCache      = make(map[string]*awsecs.DescribeTaskDefinitionOutput)
+	taskDefinitionCacheMutex sync.Mutex
+)
 
 //CreateTaskDefinitionInput ...
 type CreateTaskDefinitionInput struct {
@@ -57,7 +63,7 @@ func (a envSorter) Less(i, j int) bool {
 }
 
 //CreateTaskDefinition creates a task definition from an input
-func (ecs *ECS) CreateTaskDefinition(input *CreateTaskDefinitionInput) string {
+func (ecs *ECS) CreateTaskDefinition(input *CreateTaskDefinitionInput) (string, error) {
 	console.Debug("Creating ECS task definition")
 
 	logConfiguration := &awsecs.LogConfiguration{
@@ -103,14 +109,14 @@ func (ecs *ECS) CreateTaskDefinition(input *CreateTaskDefinitionInput) string {
 	)
 
 	if err != nil {
-		console.ErrorExit(err, "Couldn't register ECS task definition")
+		return "", awserrors.Wrap(err)
 	}
 
 	td := resp.TaskDefinition
 
 	console.Debug("Created ECS task definition [%s:%d]", aws.StringValue(td.Family), aws.Int64Value(td.Revision))
 
-	return aws.StringValue(td.TaskDefinitionArn)
+	return aws.StringValue(td.TaskDefinitionArn), nil
 }
 
 //Environment converts envvars to AWS format
@@ -199,9 +205,13 @@ func addVarsToSecrets(currentVars []*awsecs.Secret, secretVars []Secret) []*awse
 
 //DescribeTaskDefinition fetches a task definition output from cache or aws
 //(includes the taskdefinition itself along with its tags)
-func (ecs *ECS) DescribeTaskDefinition(taskDefinitionArn string) *awsecs.DescribeTaskDefinitionOutput {
-	if taskDefinitionCache[taskDefinitionArn] != nil {
-		return taskDefinitionCache[taskDefinitionArn]
+func (ecs *ECS) DescribeTaskDefinition(taskDefinitionArn string) (*awsecs.DescribeTaskDefinitionOutput, error) {
+	taskDefinitionCacheMutex.Lock()
+	cached := taskDefinitionCache[taskDefinitionArn]
+	taskDefinitionCacheMutex.Unlock()
+
+	if cached != nil {
+		return cached, nil
 	}
 
 	includeTags := "TAGS"
@@ -213,17 +223,23 @@ func (ecs *ECS) DescribeTaskDefinition(taskDefinitionArn string) *awsecs.Describ
 	)
 
 	if err != nil {
-		console.ErrorExit(err, "Could not describe ECS task definition")
+		return nil, awserrors.Wrap(err)
 	}
 
+	taskDefinitionCacheMutex.Lock()
 	taskDefinitionCache[taskDefinitionArn] = resp
+	taskDefinitionCacheMutex.Unlock()
 
-	return taskDefinitionCache[taskDefinitionArn]
+	return resp, nil
 }
 
 //UpdateTaskDefinitionImage registers a new task definition with the updated image
-func (ecs *ECS) UpdateTaskDefinitionImage(taskDefinitionArn, image string) string {
-	dtd := ecs.DescribeTaskDefinition(taskDefinitionArn)
+func (ecs *ECS) UpdateTaskDefinitionImage(taskDefinitionArn, image string) (string, error) {
+	dtd, err := ecs.DescribeTaskDefinition(taskDefinitionArn)
+	if err != nil {
+		return "", err
+	}
+
 	dtd.TaskDefinition.ContainerDefinitions[0].Image = aws.String(image)
 	return ecs.registerTaskDefinition(dtd)
 }
@@ -231,10 +247,13 @@ func (ecs *ECS) UpdateTaskDefinitionImage(taskDefinitionArn, image string) strin
 //UpdateTaskDefinitionImageAndEnvVars creates a new, updated task definition
 // based on the specified image and env vars.
 // Note that any existing envvars are replaced by the new ones
-func (ecs *ECS) UpdateTaskDefinitionImageAndEnvVars(taskDefinitionArnOrFamily string, image string, environmentVariables []EnvVar, replaceVars bool, secretVariables []Secret) string {
+func (ecs *ECS) UpdateTaskDefinitionImageAndEnvVars(taskDefinitionArnOrFamily string, image string, environmentVariables []EnvVar, replaceVars bool, secretVariables []Secret) (string, error) {
 
 	//fetch task definition details (for specific or latest active)
-	dtd := ecs.DescribeTaskDefinition(taskDefinitionArnOrFamily)
+	dtd, err := ecs.DescribeTaskDefinition(taskDefinitionArnOrFamily)
+	if err != nil {
+		return "", err
+	}
 
 	//which container are we updating?
 	container := dtd.TaskDefinition.ContainerDefinitions[0]
@@ -277,15 +296,36 @@ func (ecs *ECS) UpdateTaskDefinitionImageAndEnvVars(taskDefinitionArnOrFamily st
 
 //registers a new task definition based on a task definition output struct
 //which includes tags
-func (ecs *ECS) registerTaskDefinition(dtd *awsecs.DescribeTaskDefinitionOutput) string {
+func (ecs *ECS) registerTaskDefinition(dtd *awsecs.DescribeTaskDefinitionOutput) (string, error) {
+	return ecs.RegisterTaskDefinition(NewRegisterTaskDefinitionInput(dtd))
+}
 
+//RegisterTaskDefinition registers a new task definition and returns its arn
+func (ecs *ECS) RegisterTaskDefinition(input *awsecs.RegisterTaskDefinitionInput) (string, error) {
+	resp, err := ecs.svc.RegisterTaskDefinition(input)
+	if err != nil {
+		return "", awserrors.Wrap(err)
+	}
+
+	return aws.StringValue(resp.TaskDefinition.TaskDefinitionArn), nil
+}
+
+//NewRegisterTaskDefinitionInput builds the input needed to register a copy of
+//a described task definition (including its tags)
+func NewRegisterTaskDefinitionInput(dtd *awsecs.DescribeTaskDefinitionOutput) *awsecs.RegisterTaskDefinitionInput {
 	input := &awsecs.RegisterTaskDefinitionInput{
 		ContainerDefinitions:    dtd.TaskDefinition.ContainerDefinitions,
 		Cpu:                     dtd.TaskDefinition.Cpu,
+		EphemeralStorage:        dtd.TaskDefinition.EphemeralStorage,
 		ExecutionRoleArn:        dtd.TaskDefinition.ExecutionRoleArn,
 		Family:                  dtd.TaskDefinition.Family,
+		InferenceAccelerators:   dtd.TaskDefinition.InferenceAccelerators,
+		IpcMode:                 dtd.TaskDefinition.IpcMode,
 		Memory:                  dtd.TaskDefinition.Memory,
 		NetworkMode:             dtd.TaskDefinition.NetworkMode,
+		PidMode:                 dtd.TaskDefinition.PidMode,
+		PlacementConstraints:    dtd.TaskDefinition.PlacementConstraints,
+		ProxyConfiguration:      dtd.TaskDefinition.ProxyConfiguration,
 		RequiresCompatibilities: dtd.TaskDefinition.RequiresCompatibilities,
 		TaskRoleArn:             dtd.TaskDefinition.TaskRoleArn,
 		Volumes:                 dtd.TaskDefinition.Volumes,
@@ -297,18 +337,15 @@ func (ecs *ECS) registerTaskDefinition(dtd *awsecs.DescribeTaskDefinitionOutput)
 		input.Tags = dtd.Tags
 	}
 
-	//register a new task definition
-	resp, err := ecs.svc.RegisterTaskDefinition(input)
-	if err != nil {
-		console.ErrorExit(err, "Could not register ECS task definition")
-	}
-
-	return aws.StringValue(resp.TaskDefinition.TaskDefinitionArn)
+	return input
 }
 
 //AddEnvVarsToTaskDefinition registers a new task definition with the envvars appended
-func (ecs *ECS) AddEnvVarsToTaskDefinition(taskDefinitionArn string, envVars []EnvVar, secretVars []Secret) string {
-	dtd := ecs.DescribeTaskDefinition(taskDefinitionArn)
+func (ecs *ECS) AddEnvVarsToTaskDefinition(taskDefinitionArn string, envVars []EnvVar, secretVars []Secret) (string, error) {
+	dtd, err := ecs.DescribeTaskDefinition(taskDefinitionArn)
+	if err != nil {
+		return "", err
+	}
 
 	if len(envVars) > 0 {
 		dtd.TaskDefinition.ContainerDefinitions[0].Environment = addVarsToEnvironment(dtd.TaskDefinition.ContainerDefinitions[0].Environment, envVars)
@@ -322,12 +359,16 @@ func (ecs *ECS) AddEnvVarsToTaskDefinition(taskDefinitionArn string, envVars []E
 }
 
 //RemoveEnvVarsFromTaskDefinition registers a new task definition with the specified keys removed
-func (ecs *ECS) RemoveEnvVarsFromTaskDefinition(taskDefinitionArn string, keys []string) string {
+func (ecs *ECS) RemoveEnvVarsFromTaskDefinition(taskDefinitionArn string, keys []string) (string, error) {
 	var newEnvironment []*awsecs.KeyValuePair
 	var newSecrets []*awsecs.Secret
 
 	//look up task definition
-	dtd := ecs.DescribeTaskDefinition(taskDefinitionArn)
+	dtd, err := ecs.DescribeTaskDefinition(taskDefinitionArn)
+	if err != nil {
+		return "", err
+	}
+
 	environment := dtd.TaskDefinition.ContainerDefinitions[0].Environment
 	secrets := dtd.TaskDefinition.ContainerDefinitions[0].Secrets
 
@@ -374,10 +415,15 @@ func (ecs *ECS) RemoveEnvVarsFromTaskDefinition(taskDefinitionArn string, keys [
 }
 
 //GetEnvVarsFromTaskDefinition retrieves envvars from an existing task definition
-func (ecs *ECS) GetEnvVarsFromTaskDefinition(taskDefinitionArn string) []EnvVar {
+func (ecs *ECS) GetEnvVarsFromTaskDefinition(taskDefinitionArn string) ([]EnvVar, error) {
 	var envVars []EnvVar
 
-	taskDefinition := ecs.DescribeTaskDefinition(taskDefinitionArn).TaskDefinition
+	dtd, err := ecs.DescribeTaskDefinition(taskDefinitionArn)
+	if err != nil {
+		return envVars, err
+	}
+
+	taskDefinition := dtd.TaskDefinition
 
 	for _, keyValuePair := range taskDefinition.ContainerDefinitions[0].Environment {
 		envVars = append(envVars,
@@ -388,14 +434,19 @@ func (ecs *ECS) GetEnvVarsFromTaskDefinition(taskDefinitionArn string) []EnvVar
 		)
 	}
 
-	return envVars
+	return envVars, nil
 }
 
 //GetSecretVarsFromTaskDefinition retrieves secret vars from an existing task definition
-func (ecs *ECS) GetSecretVarsFromTaskDefinition(taskDefinitionArn string) []EnvVar {
+func (ecs *ECS) GetSecretVarsFromTaskDefinition(taskDefinitionArn string) ([]EnvVar, error) {
 	var secretVars []EnvVar
 
-	taskDefinition := ecs.DescribeTaskDefinition(taskDefinitionArn).TaskDefinition
+	dtd, err := ecs.DescribeTaskDefinition(taskDefinitionArn)
+	if err != nil {
+		return secretVars, err
+	}
+
+	taskDefinition := dtd.TaskDefinition
 
 	for _, keyValuePair := range taskDefinition.ContainerDefinitions[0].Secrets {
 		secretVars = append(secretVars,
@@ -406,12 +457,15 @@ func (ecs *ECS) GetSecretVarsFromTaskDefinition(taskDefinitionArn string) []EnvV
 		)
 	}
 
-	return secretVars
+	return secretVars, nil
 }
 
 //UpdateTaskDefinitionCpuAndMemory registers a new task definition with the cpu/memory
-func (ecs *ECS) UpdateTaskDefinitionCpuAndMemory(taskDefinitionArn, cpu, memory string) string {
-	dtd := ecs.DescribeTaskDefinition(taskDefinitionArn)
+func (ecs *ECS) UpdateTaskDefinitionCpuAndMemory(taskDefinitionArn, cpu, memory string) (string, error) {
+	dtd, err := ecs.DescribeTaskDefinition(taskDefinitionArn)
+	if err != nil {
+		return "", err
+	}
 
 	if cpu != "" {
 		dtd.TaskDefinition.Cpu = aws.String(cpu)
@@ -424,17 +478,59 @@ func (ecs *ECS) UpdateTaskDefinitionCpuAndMemory(taskDefinitionArn, cpu, memory
 	return ecs.registerTaskDefinition(dtd)
 }
 
+//TaskDefinitionFamilyExists returns whether a task definition family has active
+//revisions
+func (ecs *ECS) TaskDefinitionFamilyExists(family string) (bool, error) {
+	var found bool
+
+	err := ecs.svc.ListTaskDefinitionFamiliesPages(
+		&awsecs.ListTaskDefinitionFamiliesInput{
+			FamilyPrefix: aws.String(family),
+			Status:       aws.String(awsecs.TaskDefinitionFamilyStatusActive),
+		},
+		func(resp *awsecs.ListTaskDefinitionFamiliesOutput, lastPage bool) bool {
+			for _, f := range resp.Families {
+				if aws.StringValue(f) == family {
+					found = true
+				}
+			}
+
+			return !found
+		},
+	)
+
+	return found, awserrors.Wrap(err)
+}
+
+//ListTaskDefinitionFamilies returns the task definition families with active
+//revisions
+func (ecs *ECS) ListTaskDefinitionFamilies() ([]string, error) {
+	var families []string
+
+	err := ecs.svc.ListTaskDefinitionFamiliesPages(
+		&awsecs.ListTaskDefinitionFamiliesInput{
+			Status: aws.String(awsecs.TaskDefinitionFamilyStatusActive),
+		},
+		func(resp *awsecs.ListTaskDefinitionFamiliesOutput, lastPage bool) bool {
+			families = append(families, aws.StringValueSlice(resp.Families)...)
+
+			return true
+		},
+	)
+
+	if err != nil {
+		return families, awserrors.Wrap(err)
+	}
+
+	return families, nil
+}
+
 //GetRevisionNumber returns the revision number from a task definition
 func (ecs *ECS) GetRevisionNumber(taskDefinitionArn string) string {
 	contents := strings.Split(taskDefinitionArn, ":")
 	return contents[len(contents)-1]
 }
 
-//GetTaskDefinitionARN builds an ARN
-func (ecs *ECS) GetTaskDefinitionARN(region string, account string, family string, revisionNumber string) string {
-	return fmt.Sprintf("arn:aws:ecs:%s:%s:task-definition/%s:%s", region, account, family, revisionNumber)
-}
-
 //GetTaskFamily returns the task family from a task definition ARN
 func (ecs *ECS) GetTaskFamily(taskDefinitionArn string) string {
 	contents := strings.Split(taskDefinitionArn, ":")
@@ -442,9 +538,13 @@ func (ecs *ECS) GetTaskFamily(taskDefinitionArn string) string {
 }
 
 //GetCpuAndMemoryFromTaskDefinition returns the cpu/memory from a task definition
-func (ecs *ECS) GetCpuAndMemoryFromTaskDefinition(taskDefinitionArn string) (string, string) {
-	taskDefinition := ecs.DescribeTaskDefinition(taskDefinitionArn).TaskDefinition
-	return aws.StringValue(taskDefinition.Cpu), aws.StringValue(taskDefinition.Memory)
+func (ecs *ECS) GetCpuAndMemoryFromTaskDefinition(taskDefinitionArn string) (string, string, error) {
+	dtd, err := ecs.DescribeTaskDefinition(taskDefinitionArn)
+	if err != nil {
+		return "", "", err
+	}
+
+	return aws.StringValue(dtd.TaskDefinition.Cpu), aws.StringValue(dtd.TaskDefinition.Memory), nil
 }
 
 //ResolveRevisionNumber returns a task defintion revision number by absolute value or expression
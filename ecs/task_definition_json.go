@@ -0,0 +1,125 @@
+package ecs
+
+import (
+	"encoding/json"
+	"reflect"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awsecs "github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/turnerlabs/fargate/awserrors"
+)
+
+//MarshalTaskDefinitionInput renders a register task definition input as
+//indented JSON using the same field names as the ECS API and the AWS CLI
+//(e.g. aws ecs register-task-definition --cli-input-json)
+func MarshalTaskDefinitionInput(input *awsecs.RegisterTaskDefinitionInput) ([]byte, error) {
+	return json.MarshalIndent(apiValue(reflect.ValueOf(input)), "", "  ")
+}
+
+//apiValue converts an sdk value to plain maps and slices keyed by the
+//locationName of each field, leaving out unset fields
+func apiValue(v reflect.Value) interface{} {
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if v.IsNil() {
+			return nil
+		}
+
+		return apiValue(v.Elem())
+	case reflect.Struct:
+		fields := make(map[string]interface{})
+
+		for i := 0; i < v.NumField(); i++ {
+			field := v.Type().Field(i)
+			if field.PkgPath != "" {
+				continue
+			}
+
+			value := apiValue(v.Field(i))
+			if value == nil {
+				continue
+			}
+
+			name := field.Tag.Get("locationName")
+			if name == "" {
+				name = field.Name
+			}
+
+			fields[name] = value
+		}
+
+		return fields
+	case reflect.Slice:
+		if v.IsNil() {
+			return nil
+		}
+
+		items := make([]interface{}, v.Len())
+		for i := range items {
+			items[i] = apiValue(v.Index(i))
+		}
+
+		return items
+	case reflect.Map:
+		if v.IsNil() {
+			return nil
+		}
+
+		entries := make(map[string]interface{})
+		for _, key := range v.MapKeys() {
+			entries[key.String()] = apiValue(v.MapIndex(key))
+		}
+
+		return entries
+	default:
+		return v.Interface()
+	}
+}
+
+//UnmarshalTaskDefinitionInput parses a register task definition input from JSON.
+//Fields that are only returned when describing a task definition (e.g. revision
+//or status) are ignored. At least one container definition is required.
+func UnmarshalTaskDefinitionInput(data []byte) (*awsecs.RegisterTaskDefinitionInput, error) {
+	input := &awsecs.RegisterTaskDefinitionInput{}
+
+	//the sdk field names are the API field names capitalized, which
+	//encoding/json matches case insensitively
+	if err := json.Unmarshal(data, input); err != nil {
+		return nil, err
+	}
+
+	if err := input.Validate(); err != nil {
+		return nil, err
+	}
+
+	if len(input.ContainerDefinitions) == 0 {
+		return nil, awserrors.New(awserrors.InvalidInput, "Task definition %s has no container definitions", aws.StringValue(input.Family))
+	}
+
+	return input, nil
+}
+
+//RegisterTaskDefinitionFromInput registers a task definition from an input,
+//optionally overriding the image and adding/updating the env vars and secrets
+//of the first container
+func (ecs *ECS) RegisterTaskDefinitionFromInput(input *awsecs.RegisterTaskDefinitionInput, image string, envVars []EnvVar, secretVars []Secret) (string, error) {
+	if len(input.ContainerDefinitions) == 0 {
+		return "", awserrors.New(awserrors.InvalidInput, "Task definition %s has no container definitions", aws.StringValue(input.Family))
+	}
+
+	container := input.ContainerDefinitions[0]
+
+	if image != "" {
+		container.Image = aws.String(image)
+	}
+
+	if len(envVars) > 0 {
+		container.Environment = addVarsToEnvironment(container.Environment, envVars)
+	}
+
+	if len(secretVars) > 0 {
+		container.Secrets = addVarsToSecrets(container.Secrets, secretVars)
+	}
+
+	return ecs.RegisterTaskDefinition(input)
+}
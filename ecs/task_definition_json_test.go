@@ -0,0 +1,115 @@
+package ecs
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awsecs "github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/turnerlabs/fargate/awserrors"
+)
+
+func TestMarshalTaskDefinitionInput_RoundTrip(t *testing.T) {
+	dtd := &awsecs.DescribeTaskDefinitionOutput{
+		TaskDefinition: &awsecs.TaskDefinition{
+			Family:   aws.String("my-app"),
+			Cpu:      aws.String("256"),
+			Memory:   aws.String("512"),
+			Revision: aws.Int64(42),
+			ContainerDefinitions: []*awsecs.ContainerDefinition{
+				&awsecs.ContainerDefinition{
+					Name:  aws.String("app"),
+					Image: aws.String("my-app:1.0"),
+					HealthCheck: &awsecs.HealthCheck{
+						Command: aws.StringSlice([]string{"CMD-SHELL", "curl -f http://localhost/ || exit 1"}),
+					},
+				},
+			},
+			Volumes: []*awsecs.Volume{
+				&awsecs.Volume{Name: aws.String("data")},
+			},
+		},
+		Tags: []*awsecs.Tag{
+			&awsecs.Tag{Key: aws.String("env"), Value: aws.String("dev")},
+		},
+	}
+
+	bits, err := MarshalTaskDefinitionInput(NewRegisterTaskDefinitionInput(dtd))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !strings.Contains(string(bits), `"containerDefinitions"`) {
+		t.Errorf("Expected API field names, got %s", bits)
+	}
+
+	if strings.Contains(string(bits), `"revision"`) {
+		t.Errorf("Expected revision to be omitted, got %s", bits)
+	}
+
+	input, err := UnmarshalTaskDefinitionInput(bits)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if aws.StringValue(input.Family) != "my-app" {
+		t.Errorf("Expected family my-app, got %s", aws.StringValue(input.Family))
+	}
+
+	if len(input.ContainerDefinitions[0].HealthCheck.Command) != 2 {
+		t.Error("Expected health check to be preserved")
+	}
+
+	if len(input.Volumes) != 1 || len(input.Tags) != 1 {
+		t.Error("Expected volumes and tags to be preserved")
+	}
+}
+
+func TestUnmarshalTaskDefinitionInput_Invalid(t *testing.T) {
+	if _, err := UnmarshalTaskDefinitionInput([]byte(`{"cpu": "256"}`)); err == nil {
+		t.Error("Expected an error for a missing family and container definitions")
+	}
+}
+
+func TestUnmarshalTaskDefinitionInput_NoContainerDefinitions(t *testing.T) {
+	_, err := UnmarshalTaskDefinitionInput([]byte(`{"family": "web", "containerDefinitions": []}`))
+
+	if !awserrors.IsInvalidInput(err) {
+		t.Errorf("Expected an invalid input error, got %v", err)
+	}
+}
+
+func TestMarshalTaskDefinitionInput_FieldNames(t *testing.T) {
+	input := &awsecs.RegisterTaskDefinitionInput{
+		Family: aws.String("web"),
+		ContainerDefinitions: []*awsecs.ContainerDefinition{
+			&awsecs.ContainerDefinition{
+				Name:         aws.String("web"),
+				DockerLabels: aws.StringMap(map[string]string{"Team": "platform"}),
+			},
+		},
+	}
+
+	bits, err := MarshalTaskDefinitionInput(input)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !strings.Contains(string(bits), `"dockerLabels"`) || !strings.Contains(string(bits), `"Team"`) {
+		t.Errorf("Expected API field names and map keys as is, got %s", bits)
+	}
+
+	if strings.Contains(string(bits), "null") {
+		t.Errorf("Expected unset fields to be omitted, got %s", bits)
+	}
+}
+
+func TestRegisterTaskDefinitionFromInput_NoContainerDefinitions(t *testing.T) {
+	ecs := ECS{}
+
+	_, err := ecs.RegisterTaskDefinitionFromInput(&awsecs.RegisterTaskDefinitionInput{Family: aws.String("web")}, "web:1.0", nil, nil)
+
+	if !awserrors.IsInvalidInput(err) {
+		t.Errorf("Expected an invalid input error, got %v", err)
+	}
+}
@@ -1,9 +1,14 @@
 package ecs
 
 import (
+	"fmt"
+	"sync"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws/session"
+	awsecs "github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/golang/mock/gomock"
+	"github.com/turnerlabs/fargate/ecs/mock/sdk"
 )
 
 func TestSortEnvVars(t *testing.T) {
@@ -125,3 +130,29 @@ func TestResolveRevisionNumber_InvalidInput(t *testing.T) {
 		t.Error("Expected empty string")
 	}
 }
+
+func TestDescribeTaskDefinitionConcurrently(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mockECSClient := sdk.NewMockECSAPI(mockCtrl)
+	ecs := NewWithClient(mockECSClient, "my-cluster")
+
+	mockECSClient.EXPECT().DescribeTaskDefinition(gomock.Any()).Return(&awsecs.DescribeTaskDefinitionOutput{}, nil).AnyTimes()
+
+	var wg sync.WaitGroup
+
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+
+		go func(i int) {
+			defer wg.Done()
+
+			if _, err := ecs.DescribeTaskDefinition(fmt.Sprintf("describe-concurrently:%d", i)); err != nil {
+				t.Errorf("Expected no error, got %v", err)
+			}
+		}(i)
+	}
+
+	wg.Wait()
+}
@@ -0,0 +1,62 @@
+package ecs
+
+import (
+	"encoding/json"
+	"reflect"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awsecs "github.com/aws/aws-sdk-go/service/ecs"
+)
+
+// NewTaskOverride returns a task override that replaces the command and
+// adds environment variables to a container. It returns nil if there is
+// nothing to override.
+func NewTaskOverride(containerName string, command []string, envVars []EnvVar) *awsecs.TaskOverride {
+	if len(command) == 0 && len(envVars) == 0 {
+		return nil
+	}
+
+	containerOverride := &awsecs.ContainerOverride{
+		Name: aws.String(containerName),
+	}
+
+	if len(command) > 0 {
+		containerOverride.SetCommand(aws.StringSlice(command))
+	}
+
+	for _, envVar := range envVars {
+		containerOverride.Environment = append(
+			containerOverride.Environment,
+			&awsecs.KeyValuePair{
+				Name:  aws.String(envVar.Key),
+				Value: aws.String(envVar.Value),
+			},
+		)
+	}
+
+	return &awsecs.TaskOverride{
+		ContainerOverrides: []*awsecs.ContainerOverride{containerOverride},
+	}
+}
+
+// MarshalTaskOverride renders a task override as JSON using the same field
+// names as the ECS API (e.g. as the input of a cloudwatch events ECS target)
+func MarshalTaskOverride(override *awsecs.TaskOverride) (string, error) {
+	bits, err := json.Marshal(apiValue(reflect.ValueOf(override)))
+	if err != nil {
+		return "", err
+	}
+
+	return string(bits), nil
+}
+
+// UnmarshalTaskOverride parses a task override from JSON
+func UnmarshalTaskOverride(data string) (*awsecs.TaskOverride, error) {
+	override := &awsecs.TaskOverride{}
+
+	if err := json.Unmarshal([]byte(data), override); err != nil {
+		return nil, err
+	}
+
+	return override, nil
+}
@@ -0,0 +1,46 @@
+package ecs
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+)
+
+func TestTaskOverrideRoundTrip(t *testing.T) {
+	override := NewTaskOverride("app", []string{"bin/job", "--all"}, []EnvVar{{Key: "FOO", Value: "bar"}})
+
+	data, err := MarshalTaskOverride(override)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `{"containerOverrides":[{"command":["bin/job","--all"],"environment":[{"name":"FOO","value":"bar"}],"name":"app"}]}`
+	if data != expected {
+		t.Errorf("Expected %s, got %s", expected, data)
+	}
+
+	result, err := UnmarshalTaskOverride(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	container := result.ContainerOverrides[0]
+
+	if aws.StringValue(container.Name) != "app" {
+		t.Errorf("Expected app, got %s", aws.StringValue(container.Name))
+	}
+
+	if len(container.Command) != 2 || aws.StringValue(container.Command[1]) != "--all" {
+		t.Errorf("Expected command [bin/job --all], got %v", aws.StringValueSlice(container.Command))
+	}
+
+	if aws.StringValue(container.Environment[0].Value) != "bar" {
+		t.Errorf("Expected bar, got %s", aws.StringValue(container.Environment[0].Value))
+	}
+}
+
+func TestNewTaskOverride_Empty(t *testing.T) {
+	if override := NewTaskOverride("app", nil, nil); override != nil {
+		t.Errorf("Expected nil, got %v", override)
+	}
+}
@@ -3,7 +3,11 @@ package ecs
 import (
 	"testing"
 
+	"github.com/aws/aws-sdk-go/aws"
 	awsecs "github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/golang/mock/gomock"
+	"github.com/turnerlabs/fargate/awserrors"
+	"github.com/turnerlabs/fargate/ecs/mock/sdk"
 )
 
 // Test behavior for when there are no eni details
@@ -111,3 +115,137 @@ func TestDetermineENIDetails_ServiceConnect(t *testing.T) {
 		t.Errorf("Should find subnetid. Was %s expected %s", subnetResult, expectedSubnet)
 	}
 }
+
+func TestRunTaskLaunchType(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mockECSClient := sdk.NewMockECSAPI(mockCtrl)
+	ecs := NewWithClient(mockECSClient, "my-cluster")
+
+	mockECSClient.EXPECT().RunTask(gomock.Any()).DoAndReturn(
+		func(input *awsecs.RunTaskInput) (*awsecs.RunTaskOutput, error) {
+			if launchType := aws.StringValue(input.LaunchType); launchType != awsecs.CompatibilityFargate {
+				t.Errorf("Expected %s, got %s", awsecs.CompatibilityFargate, launchType)
+			}
+
+			if len(input.CapacityProviderStrategy) != 0 {
+				t.Errorf("Expected no capacity provider strategy, got %v", input.CapacityProviderStrategy)
+			}
+
+			return &awsecs.RunTaskOutput{
+				Tasks: []*awsecs.Task{
+					&awsecs.Task{TaskArn: aws.String("arn:aws:ecs:us-east-1:123456789012:task/my-cluster/abc")},
+				},
+			}, nil
+		},
+	)
+
+	taskIds, err := ecs.RunTask(&RunTaskInput{ClusterName: "my-cluster", Count: 1, TaskName: "job"})
+
+	if err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+
+	if len(taskIds) != 1 || taskIds[0] != "abc" {
+		t.Errorf("Expected [abc], got %v", taskIds)
+	}
+}
+
+func TestRunTaskNetworkConfiguration(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mockECSClient := sdk.NewMockECSAPI(mockCtrl)
+	ecs := NewWithClient(mockECSClient, "my-cluster")
+
+	gomock.InOrder(
+		mockECSClient.EXPECT().RunTask(gomock.Any()).DoAndReturn(
+			func(input *awsecs.RunTaskInput) (*awsecs.RunTaskOutput, error) {
+				if input.NetworkConfiguration != nil {
+					t.Errorf("Expected no network configuration without subnets, got %v", input.NetworkConfiguration)
+				}
+
+				return &awsecs.RunTaskOutput{}, nil
+			},
+		),
+		mockECSClient.EXPECT().RunTask(gomock.Any()).DoAndReturn(
+			func(input *awsecs.RunTaskInput) (*awsecs.RunTaskOutput, error) {
+				if input.NetworkConfiguration == nil {
+					t.Fatal("Expected a network configuration")
+				}
+
+				awsvpc := input.NetworkConfiguration.AwsvpcConfiguration
+
+				if aws.StringValue(awsvpc.AssignPublicIp) != awsecs.AssignPublicIpDisabled || len(awsvpc.Subnets) != 1 {
+					t.Errorf("Unexpected awsvpc configuration %v", awsvpc)
+				}
+
+				return &awsecs.RunTaskOutput{}, nil
+			},
+		),
+	)
+
+	ecs.RunTask(&RunTaskInput{ClusterName: "my-cluster", Count: 1, LaunchType: awsecs.LaunchTypeEc2})
+	ecs.RunTask(&RunTaskInput{ClusterName: "my-cluster", Count: 1, SubnetIds: []string{"subnet-1234"}, AssignPublicIp: awsecs.AssignPublicIpDisabled})
+}
+
+func TestRunTaskCapacityProviderStrategy(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mockECSClient := sdk.NewMockECSAPI(mockCtrl)
+	ecs := NewWithClient(mockECSClient, "my-cluster")
+
+	mockECSClient.EXPECT().RunTask(gomock.Any()).DoAndReturn(
+		func(input *awsecs.RunTaskInput) (*awsecs.RunTaskOutput, error) {
+			if input.LaunchType != nil {
+				t.Errorf("Expected no launch type, got %s", aws.StringValue(input.LaunchType))
+			}
+
+			if len(input.CapacityProviderStrategy) != 1 || aws.StringValue(input.CapacityProviderStrategy[0].CapacityProvider) != "FARGATE_SPOT" {
+				t.Errorf("Expected FARGATE_SPOT strategy, got %v", input.CapacityProviderStrategy)
+			}
+
+			return &awsecs.RunTaskOutput{}, nil
+		},
+	)
+
+	ecs.RunTask(
+		&RunTaskInput{
+			ClusterName:              "my-cluster",
+			Count:                    1,
+			CapacityProviderStrategy: []CapacityProviderStrategyItem{CapacityProviderStrategyItem{CapacityProvider: "FARGATE_SPOT", Weight: 1}},
+		},
+	)
+}
+
+func TestDescribeTasksMissing(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mockECSClient := sdk.NewMockECSAPI(mockCtrl)
+	ecs := NewWithClient(mockECSClient, "my-cluster")
+
+	mockECSClient.EXPECT().DescribeTasks(gomock.Any()).Return(
+		&awsecs.DescribeTasksOutput{
+			Failures: []*awsecs.Failure{
+				&awsecs.Failure{
+					Arn:    aws.String("arn:aws:ecs:us-east-1:123456789012:task/my-cluster/abc"),
+					Reason: aws.String("MISSING"),
+				},
+			},
+		},
+		nil,
+	)
+
+	tasks, err := ecs.DescribeTasks([]string{"abc"})
+
+	if len(tasks) != 0 {
+		t.Errorf("Expected no tasks, got %v", tasks)
+	}
+
+	if !awserrors.IsNotFound(err) {
+		t.Errorf("Expected a not found error, got %v", err)
+	}
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	awselbv2 "github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/turnerlabs/fargate/awserrors"
 	"github.com/turnerlabs/fargate/console"
 )
 
@@ -99,7 +100,7 @@ func (elbv2 SDKClient) CreateListener(p CreateListenerParameters) (string, error
 	resp, err := elbv2.client.CreateListener(i)
 
 	if err != nil {
-		return "", err
+		return "", awserrors.Wrap(err)
 	}
 
 	return aws.StringValue(resp.Listeners[0].ListenerArn), nil
@@ -134,40 +135,58 @@ func (elbv2 SDKClient) DescribeListeners(lbARN string) (Listeners, error) {
 		},
 	)
 
-	return listeners, err
+	return listeners, awserrors.Wrap(err)
 }
 
-func (elbv2 SDKClient) ModifyLoadBalancerDefaultAction(lbARN, targetGroupARN string) {
-	for _, listener := range elbv2.GetListeners(lbARN) {
-		elbv2.ModifyListenerDefaultAction(listener.ARN, targetGroupARN)
+func (elbv2 SDKClient) ModifyLoadBalancerDefaultAction(lbARN, targetGroupARN string) error {
+	listeners, err := elbv2.GetListeners(lbARN)
+	if err != nil {
+		return err
+	}
+
+	for _, listener := range listeners {
+		if err := elbv2.ModifyListenerDefaultAction(listener.ARN, targetGroupARN); err != nil {
+			return err
+		}
 	}
+
+	return nil
 }
 
-func (elbv2 SDKClient) ModifyListenerDefaultAction(listenerARN, targetGroupARN string) {
+func (elbv2 SDKClient) ModifyListenerDefaultAction(listenerARN, targetGroupARN string) error {
 	action := &awselbv2.Action{
 		TargetGroupArn: aws.String(targetGroupARN),
 		Type:           aws.String(awselbv2.ActionTypeEnumForward),
 	}
 
-	elbv2.client.ModifyListener(
+	_, err := elbv2.client.ModifyListener(
 		&awselbv2.ModifyListenerInput{
 			ListenerArn:    aws.String(listenerARN),
 			DefaultActions: []*awselbv2.Action{action},
 		},
 	)
+
+	return awserrors.Wrap(err)
 }
 
-func (elbv2 SDKClient) AddRule(lbARN, targetGroupARN string, rule Rule) {
+func (elbv2 SDKClient) AddRule(lbARN, targetGroupARN string, rule Rule) error {
 	console.Debug("Adding ELB listener rule [%s=%s]", rule.Type, rule.Value)
 
-	listeners := elbv2.GetListeners(lbARN)
+	listeners, err := elbv2.GetListeners(lbARN)
+	if err != nil {
+		return err
+	}
 
 	for _, listener := range listeners {
-		elbv2.AddRuleToListener(listener.ARN, targetGroupARN, rule)
+		if err := elbv2.AddRuleToListener(listener.ARN, targetGroupARN, rule); err != nil {
+			return err
+		}
 	}
+
+	return nil
 }
 
-func (elbv2 SDKClient) AddRuleToListener(listenerARN, targetGroupARN string, rule Rule) {
+func (elbv2 SDKClient) AddRuleToListener(listenerARN, targetGroupARN string, rule Rule) error {
 	var ruleType string
 
 	if rule.Type == "HOST" {
@@ -180,14 +199,17 @@ func (elbv2 SDKClient) AddRuleToListener(listenerARN, targetGroupARN string, rul
 		Field:  aws.String(ruleType),
 		Values: aws.StringSlice([]string{rule.Value}),
 	}
-	highestPriority := elbv2.GetHighestPriorityFromListener(listenerARN)
+	highestPriority, err := elbv2.GetHighestPriorityFromListener(listenerARN)
+	if err != nil {
+		return err
+	}
 	priority := highestPriority + 10
 	action := &awselbv2.Action{
 		TargetGroupArn: aws.String(targetGroupARN),
 		Type:           aws.String(awselbv2.ActionTypeEnumForward),
 	}
 
-	elbv2.client.CreateRule(
+	_, err = elbv2.client.CreateRule(
 		&awselbv2.CreateRuleInput{
 			Priority:    aws.Int64(priority),
 			ListenerArn: aws.String(listenerARN),
@@ -195,9 +217,11 @@ func (elbv2 SDKClient) AddRuleToListener(listenerARN, targetGroupARN string, rul
 			Conditions:  []*awselbv2.RuleCondition{ruleCondition},
 		},
 	)
+
+	return awserrors.Wrap(err)
 }
 
-func (elbv2 SDKClient) DescribeRules(listenerARN string) []Rule {
+func (elbv2 SDKClient) DescribeRules(listenerARN string) ([]Rule, error) {
 	var rules []Rule
 
 	resp, err := elbv2.client.DescribeRules(
@@ -207,7 +231,7 @@ func (elbv2 SDKClient) DescribeRules(listenerARN string) []Rule {
 	)
 
 	if err != nil {
-		console.ErrorExit(err, "Could not describe ELB rules")
+		return rules, awserrors.Wrap(err)
 	}
 
 	for _, r := range resp.Rules {
@@ -247,10 +271,10 @@ func (elbv2 SDKClient) DescribeRules(listenerARN string) []Rule {
 		}
 	}
 
-	return rules
+	return rules, nil
 }
 
-func (elbv2 SDKClient) GetHighestPriorityFromListener(listenerARN string) int64 {
+func (elbv2 SDKClient) GetHighestPriorityFromListener(listenerARN string) (int64, error) {
 	var priorities []int
 
 	resp, err := elbv2.client.DescribeRules(
@@ -260,7 +284,7 @@ func (elbv2 SDKClient) GetHighestPriorityFromListener(listenerARN string) int64
 	)
 
 	if err != nil {
-		console.ErrorExit(err, "Could not retrieve ELB listener rules")
+		return 0, awserrors.Wrap(err)
 	}
 
 	for _, rule := range resp.Rules {
@@ -270,55 +294,19 @@ func (elbv2 SDKClient) GetHighestPriorityFromListener(listenerARN string) int64
 
 	sort.Ints(priorities)
 
-	return int64(priorities[len(priorities)-1])
+	return int64(priorities[len(priorities)-1]), nil
 }
 
-func (elbv2 SDKClient) GetListeners(lbARN string) []Listener {
-	var listeners []Listener
-
-	input := &awselbv2.DescribeListenersInput{
-		LoadBalancerArn: aws.String(lbARN),
-	}
-
-	err := elbv2.client.DescribeListenersPages(
-		input,
-		func(resp *awselbv2.DescribeListenersOutput, lastPage bool) bool {
-			for _, l := range resp.Listeners {
-				listener := Listener{
-					ARN:      aws.StringValue(l.ListenerArn),
-					Port:     aws.Int64Value(l.Port),
-					Protocol: aws.StringValue(l.Protocol),
-				}
-
-				for _, certificate := range l.Certificates {
-					listener.CertificateARNs = append(
-						listener.CertificateARNs,
-						aws.StringValue(certificate.CertificateArn),
-					)
-				}
-
-				listeners = append(listeners, listener)
-			}
-
-			return true
-		},
-	)
-
-	if err != nil {
-		console.ErrorExit(err, "Could not retrieve ELB listeners")
-	}
-
-	return listeners
+func (elbv2 SDKClient) GetListeners(lbARN string) ([]Listener, error) {
+	return elbv2.DescribeListeners(lbARN)
 }
 
-func (elbv2 SDKClient) DeleteRule(ruleARN string) {
+func (elbv2 SDKClient) DeleteRule(ruleARN string) error {
 	_, err := elbv2.client.DeleteRule(
 		&awselbv2.DeleteRuleInput{
 			RuleArn: aws.String(ruleARN),
 		},
 	)
 
-	if err != nil {
-		console.ErrorExit(err, "Could not delete ELB rule")
-	}
+	return awserrors.Wrap(err)
 }
@@ -1,11 +1,9 @@
 package elbv2
 
 import (
-	"fmt"
-
 	"github.com/aws/aws-sdk-go/aws"
 	awselbv2 "github.com/aws/aws-sdk-go/service/elbv2"
-	"github.com/turnerlabs/fargate/console"
+	"github.com/turnerlabs/fargate/awserrors"
 )
 
 // LoadBalancer represents an Elastic Load Balancing (v2) load balancer.
@@ -49,7 +47,7 @@ func (elbv2 SDKClient) CreateLoadBalancer(p CreateLoadBalancerParameters) (strin
 	resp, err := elbv2.client.CreateLoadBalancer(sdki)
 
 	if err != nil {
-		return "", err
+		return "", awserrors.Wrap(err)
 	}
 
 	return aws.StringValue(resp.LoadBalancers[0].LoadBalancerArn), nil
@@ -74,37 +72,45 @@ func (elbv2 SDKClient) DescribeLoadBalancersByARN(lbARNs []string) (LoadBalancer
 	)
 }
 
-func (elbv2 SDKClient) DescribeLoadBalancer(lbName string) LoadBalancer {
-	loadBalancers, _ := elbv2.DescribeLoadBalancersByName([]string{lbName})
+func (elbv2 SDKClient) DescribeLoadBalancer(lbName string) (LoadBalancer, error) {
+	loadBalancers, err := elbv2.DescribeLoadBalancersByName([]string{lbName})
+	if err != nil {
+		return LoadBalancer{}, err
+	}
 
 	if len(loadBalancers) == 0 {
-		console.ErrorExit(fmt.Errorf("%s not found", lbName), "Could not find ELB load balancer")
+		return LoadBalancer{}, awserrors.New(awserrors.NotFound, "%s not found", lbName)
 	}
 
-	return loadBalancers[0]
+	return loadBalancers[0], nil
 }
 
-func (elbv2 SDKClient) DescribeLoadBalancerByARN(lbARN string) LoadBalancer {
-	loadBalancers, _ := elbv2.DescribeLoadBalancersByARN([]string{lbARN})
+func (elbv2 SDKClient) DescribeLoadBalancerByARN(lbARN string) (LoadBalancer, error) {
+	loadBalancers, err := elbv2.DescribeLoadBalancersByARN([]string{lbARN})
+	if err != nil {
+		return LoadBalancer{}, err
+	}
 
 	if len(loadBalancers) == 0 {
-		console.ErrorExit(fmt.Errorf("%s not found", lbARN), "Could not find ELB load balancer")
+		return LoadBalancer{}, awserrors.New(awserrors.NotFound, "%s not found", lbARN)
 	}
 
-	return loadBalancers[0]
+	return loadBalancers[0], nil
 }
 
-func (elbv2 SDKClient) DeleteLoadBalancer(lbName string) {
-	loadBalancer := elbv2.DescribeLoadBalancer(lbName)
-	_, err := elbv2.client.DeleteLoadBalancer(
+func (elbv2 SDKClient) DeleteLoadBalancer(lbName string) error {
+	loadBalancer, err := elbv2.DescribeLoadBalancer(lbName)
+	if err != nil {
+		return err
+	}
+
+	_, err = elbv2.client.DeleteLoadBalancer(
 		&awselbv2.DeleteLoadBalancerInput{
 			LoadBalancerArn: aws.String(loadBalancer.ARN),
 		},
 	)
 
-	if err != nil {
-		console.ErrorExit(err, "Could not destroy ELB load balancer")
-	}
+	return awserrors.Wrap(err)
 }
 
 func (elbv2 SDKClient) describeLoadBalancers(i *awselbv2.DescribeLoadBalancersInput) (LoadBalancers, error) {
@@ -138,5 +144,5 @@ func (elbv2 SDKClient) describeLoadBalancers(i *awselbv2.DescribeLoadBalancersIn
 
 	err := elbv2.client.DescribeLoadBalancersPages(i, handler)
 
-	return loadBalancers, err
+	return loadBalancers, awserrors.Wrap(err)
 }
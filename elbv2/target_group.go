@@ -3,6 +3,7 @@ package elbv2
 import (
 	"github.com/aws/aws-sdk-go/aws"
 	awselbv2 "github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/turnerlabs/fargate/awserrors"
 	"github.com/turnerlabs/fargate/console"
 )
 
@@ -31,61 +32,62 @@ func (elbv2 SDKClient) CreateTargetGroup(i CreateTargetGroupParameters) (string,
 	)
 
 	if err != nil {
-		return "", err
+		return "", awserrors.Wrap(err)
 	}
 
 	return aws.StringValue(resp.TargetGroups[0].TargetGroupArn), nil
 }
 
-func (elbv2 SDKClient) DeleteTargetGroup(targetGroupName string) {
+func (elbv2 SDKClient) DeleteTargetGroup(targetGroupName string) error {
 	console.Debug("Deleting ELB target group")
 
-	targetGroup := elbv2.describeTargetGroupByName(targetGroupName)
+	targetGroup, err := elbv2.describeTargetGroupByName(targetGroupName)
+	if err != nil {
+		return err
+	}
 
-	elbv2.client.DeleteTargetGroup(
+	_, err = elbv2.client.DeleteTargetGroup(
 		&awselbv2.DeleteTargetGroupInput{
 			TargetGroupArn: targetGroup.TargetGroupArn,
 		},
 	)
+
+	return awserrors.Wrap(err)
 }
 
-func (elbv2 SDKClient) DeleteTargetGroupByArn(targetGroupARN string) {
+func (elbv2 SDKClient) DeleteTargetGroupByArn(targetGroupARN string) error {
 	_, err := elbv2.client.DeleteTargetGroup(
 		&awselbv2.DeleteTargetGroupInput{
 			TargetGroupArn: aws.String(targetGroupARN),
 		},
 	)
 
-	if err != nil {
-		console.ErrorExit(err, "Could not delete ELB target group")
-	}
+	return awserrors.Wrap(err)
 }
 
-func (elbv2 SDKClient) GetTargetGroupArn(targetGroupName string) string {
-	resp, _ := elbv2.client.DescribeTargetGroups(
-		&awselbv2.DescribeTargetGroupsInput{
-			Names: aws.StringSlice([]string{targetGroupName}),
-		},
-	)
-
-	if len(resp.TargetGroups) == 1 {
-		return aws.StringValue(resp.TargetGroups[0].TargetGroupArn)
+func (elbv2 SDKClient) GetTargetGroupArn(targetGroupName string) (string, error) {
+	targetGroup, err := elbv2.describeTargetGroupByName(targetGroupName)
+	if err != nil {
+		return "", err
 	}
 
-	return ""
+	return aws.StringValue(targetGroup.TargetGroupArn), nil
 }
 
-func (elbv2 SDKClient) GetTargetGroupLoadBalancerArn(targetGroupARN string) string {
-	targetGroup := elbv2.describeTargetGroupByArn(targetGroupARN)
+func (elbv2 SDKClient) GetTargetGroupLoadBalancerArn(targetGroupARN string) (string, error) {
+	targetGroup, err := elbv2.describeTargetGroupByArn(targetGroupARN)
+	if err != nil {
+		return "", err
+	}
 
 	if len(targetGroup.LoadBalancerArns) > 0 {
-		return aws.StringValue(targetGroup.LoadBalancerArns[0])
-	} else {
-		return ""
+		return aws.StringValue(targetGroup.LoadBalancerArns[0]), nil
 	}
+
+	return "", nil
 }
 
-func (elbv2 SDKClient) DescribeTargetGroups(targetGroupARNs []string) []TargetGroup {
+func (elbv2 SDKClient) DescribeTargetGroups(targetGroupARNs []string) ([]TargetGroup, error) {
 	var targetGroups []TargetGroup
 
 	resp, err := elbv2.client.DescribeTargetGroups(
@@ -95,7 +97,7 @@ func (elbv2 SDKClient) DescribeTargetGroups(targetGroupARNs []string) []TargetGr
 	)
 
 	if err != nil {
-		console.ErrorExit(err, "Could not describe ELB target groups")
+		return targetGroups, awserrors.Wrap(err)
 	}
 
 	for _, targetGroup := range resp.TargetGroups {
@@ -111,41 +113,37 @@ func (elbv2 SDKClient) DescribeTargetGroups(targetGroupARNs []string) []TargetGr
 		targetGroups = append(targetGroups, tg)
 	}
 
-	return targetGroups
+	return targetGroups, nil
 }
 
-func (elbv2 SDKClient) describeTargetGroupByName(targetGroupName string) *awselbv2.TargetGroup {
-	resp, err := elbv2.client.DescribeTargetGroups(
+func (elbv2 SDKClient) describeTargetGroupByName(targetGroupName string) (*awselbv2.TargetGroup, error) {
+	return elbv2.describeTargetGroup(
 		&awselbv2.DescribeTargetGroupsInput{
 			Names: aws.StringSlice([]string{targetGroupName}),
 		},
+		targetGroupName,
 	)
-
-	if err != nil {
-		console.ErrorExit(err, "Could not describe ELB target groups")
-	}
-
-	if len(resp.TargetGroups) != 1 {
-		console.IssueExit("Could not describe ELB target groups")
-	}
-
-	return resp.TargetGroups[0]
 }
 
-func (elbv2 SDKClient) describeTargetGroupByArn(targetGroupARN string) *awselbv2.TargetGroup {
-	resp, err := elbv2.client.DescribeTargetGroups(
+func (elbv2 SDKClient) describeTargetGroupByArn(targetGroupARN string) (*awselbv2.TargetGroup, error) {
+	return elbv2.describeTargetGroup(
 		&awselbv2.DescribeTargetGroupsInput{
 			TargetGroupArns: aws.StringSlice([]string{targetGroupARN}),
 		},
+		targetGroupARN,
 	)
+}
+
+func (elbv2 SDKClient) describeTargetGroup(i *awselbv2.DescribeTargetGroupsInput, id string) (*awselbv2.TargetGroup, error) {
+	resp, err := elbv2.client.DescribeTargetGroups(i)
 
 	if err != nil {
-		console.ErrorExit(err, "Could not describe ELB target groups")
+		return nil, awserrors.Wrap(err)
 	}
 
 	if len(resp.TargetGroups) != 1 {
-		console.IssueExit("Could not describe ELB target groups")
+		return nil, awserrors.New(awserrors.NotFound, "target group %s not found", id)
 	}
 
-	return resp.TargetGroups[0]
+	return resp.TargetGroups[0], nil
 }
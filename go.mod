@@ -7,7 +7,6 @@ toolchain go1.24.3
 require (
 	github.com/aws/aws-sdk-go v1.44.253
 	github.com/golang/mock v1.6.0
-	github.com/hashicorp/golang-lru v0.5.4
 	github.com/kyokomi/emoji v2.2.4+incompatible
 	github.com/mgutz/ansi v0.0.0-20200706080929-d51e80ef957d
 	github.com/spf13/cobra v1.0.0
@@ -17,6 +16,8 @@ require (
 	gopkg.in/yaml.v2 v2.3.0
 )
 
+require github.com/spf13/pflag v1.0.3
+
 require (
 	github.com/fsnotify/fsnotify v1.4.7 // indirect
 	github.com/hashicorp/hcl v1.0.0 // indirect
@@ -30,7 +31,6 @@ require (
 	github.com/spf13/afero v1.1.2 // indirect
 	github.com/spf13/cast v1.3.0 // indirect
 	github.com/spf13/jwalterweatherman v1.0.0 // indirect
-	github.com/spf13/pflag v1.0.3 // indirect
 	github.com/subosito/gotenv v1.2.0 // indirect
 	golang.org/x/sys v0.30.0 // indirect
 	golang.org/x/term v0.29.0 // indirect
@@ -1,16 +1,24 @@
 package servicediscovery
 
+//go:generate mockgen -package sdk -source ../vendor/github.com/aws/aws-sdk-go/service/servicediscovery/servicediscoveryiface/interface.go -destination=mock/sdk/servicediscoveryiface.go github.com/aws/aws-sdk-go/service/servicediscovery/servicediscoveryiface ServiceDiscoveryAPI
+
 import (
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/servicediscovery"
+	"github.com/aws/aws-sdk-go/service/servicediscovery/servicediscoveryiface"
 )
 
 type ServiceDiscovery struct {
-	svc *servicediscovery.ServiceDiscovery
+	svc servicediscoveryiface.ServiceDiscoveryAPI
 }
 
 func New(sess *session.Session) ServiceDiscovery {
+	return NewWithClient(servicediscovery.New(sess))
+}
+
+// NewWithClient returns a ServiceDiscovery that calls the given client.
+func NewWithClient(client servicediscoveryiface.ServiceDiscoveryAPI) ServiceDiscovery {
 	return ServiceDiscovery{
-		svc: servicediscovery.New(sess),
+		svc: client,
 	}
 }
@@ -3,7 +3,7 @@ package servicediscovery
 import (
 	"github.com/aws/aws-sdk-go/aws"
 	awssd "github.com/aws/aws-sdk-go/service/servicediscovery"
-	"github.com/turnerlabs/fargate/console"
+	"github.com/turnerlabs/fargate/awserrors"
 )
 
 type Namespace struct {
@@ -12,7 +12,7 @@ type Namespace struct {
 	Private bool
 }
 
-func (sd *ServiceDiscovery) GetNamespace(namespaceId string) Namespace {
+func (sd *ServiceDiscovery) GetNamespace(namespaceId string) (Namespace, error) {
 	var namespace Namespace
 
 	resp, err := sd.svc.GetNamespace(
@@ -22,7 +22,7 @@ func (sd *ServiceDiscovery) GetNamespace(namespaceId string) Namespace {
 	)
 
 	if err != nil {
-		console.ErrorExit(err, "Could not describe ServiceDiscovery namespace")
+		return namespace, awserrors.Wrap(err)
 	}
 
 	namespace = Namespace{
@@ -31,5 +31,5 @@ func (sd *ServiceDiscovery) GetNamespace(namespaceId string) Namespace {
 		Private: aws.StringValue(resp.Namespace.Type) == awssd.NamespaceTypeDnsPrivate,
 	}
 
-	return namespace
+	return namespace, nil
 }
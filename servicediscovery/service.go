@@ -5,7 +5,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	awssd "github.com/aws/aws-sdk-go/service/servicediscovery"
-	"github.com/turnerlabs/fargate/console"
+	"github.com/turnerlabs/fargate/awserrors"
 )
 
 type DnsRecord struct {
@@ -20,7 +20,7 @@ type Service struct {
 	Namespace  Namespace
 }
 
-func (sd *ServiceDiscovery) GetService(registryArn string) Service {
+func (sd *ServiceDiscovery) GetService(registryArn string) (Service, error) {
 	var service Service
 
 	arnSlice := strings.Split(registryArn, "/")
@@ -33,13 +33,17 @@ func (sd *ServiceDiscovery) GetService(registryArn string) Service {
 	)
 
 	if err != nil {
-		console.ErrorExit(err, "Could not describe ServiceDiscovery service")
+		return service, awserrors.Wrap(err)
 	}
 
-	namespace := sd.GetNamespace(
+	namespace, err := sd.GetNamespace(
 		aws.StringValue(resp.Service.DnsConfig.NamespaceId),
 	)
 
+	if err != nil {
+		return service, err
+	}
+
 	service = Service{
 		Id:        id,
 		Name:      aws.StringValue(resp.Service.Name),
@@ -56,5 +60,5 @@ func (sd *ServiceDiscovery) GetService(registryArn string) Service {
 		)
 	}
 
-	return service
+	return service, nil
 }
@@ -1,20 +1,28 @@
 package sts
 
+//go:generate mockgen -package sdk -source ../vendor/github.com/aws/aws-sdk-go/service/sts/stsiface/interface.go -destination=mock/sdk/stsiface.go github.com/aws/aws-sdk-go/service/sts/stsiface STSAPI
+
 import (
 	"github.com/aws/aws-sdk-go/aws/session"
 	awssts "github.com/aws/aws-sdk-go/service/sts"
-	"github.com/turnerlabs/fargate/console"
+	"github.com/aws/aws-sdk-go/service/sts/stsiface"
+	"github.com/turnerlabs/fargate/awserrors"
 )
 
 //STS represents an STS API
 type STS struct {
-	svc *awssts.STS
+	svc stsiface.STSAPI
 }
 
 //New creates a new STS value
 func New(sess *session.Session) STS {
+	return NewWithClient(awssts.New(sess))
+}
+
+//NewWithClient creates a new STS value that calls the given api
+func NewWithClient(client stsiface.STSAPI) STS {
 	return STS{
-		svc: awssts.New(sess),
+		svc: client,
 	}
 }
 
@@ -27,11 +35,11 @@ type CallerIdentity struct {
 }
 
 //GetCallerIdentity calls GetCallerIdentity
-func (s *STS) GetCallerIdentity() CallerIdentity {
+func (s *STS) GetCallerIdentity() (CallerIdentity, error) {
 	input := &awssts.GetCallerIdentityInput{}
 	resp, err := s.svc.GetCallerIdentity(input)
 	if err != nil {
-		console.ErrorExit(err, "Error calling GetCallerIdentity")
+		return CallerIdentity{}, awserrors.Wrap(err)
 	}
 	result := CallerIdentity{
 		Account: *resp.Account,
@@ -39,5 +47,5 @@ func (s *STS) GetCallerIdentity() CallerIdentity {
 		UserID:  *resp.UserId,
 	}
 
-	return result
+	return result, nil
 }
@@ -0,0 +1,58 @@
+package sts
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	awssts "github.com/aws/aws-sdk-go/service/sts"
+	"github.com/golang/mock/gomock"
+	"github.com/turnerlabs/fargate/awserrors"
+	"github.com/turnerlabs/fargate/sts/mock/sdk"
+)
+
+func TestGetCallerIdentity(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mockSTSClient := sdk.NewMockSTSAPI(mockCtrl)
+	sts := NewWithClient(mockSTSClient)
+
+	mockSTSClient.EXPECT().GetCallerIdentity(&awssts.GetCallerIdentityInput{}).Return(
+		&awssts.GetCallerIdentityOutput{
+			Account: aws.String("123456789012"),
+			Arn:     aws.String("arn:aws:iam::123456789012:user/deploy"),
+			UserId:  aws.String("AIDAEXAMPLE"),
+		},
+		nil,
+	)
+
+	identity, err := sts.GetCallerIdentity()
+
+	if err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+
+	if identity.Account != "123456789012" {
+		t.Errorf("Expected 123456789012, got %s", identity.Account)
+	}
+}
+
+func TestGetCallerIdentityExpiredToken(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mockSTSClient := sdk.NewMockSTSAPI(mockCtrl)
+	sts := NewWithClient(mockSTSClient)
+
+	mockSTSClient.EXPECT().GetCallerIdentity(gomock.Any()).Return(
+		nil,
+		awserr.New("ExpiredTokenException", "The security token included in the request is expired", nil),
+	)
+
+	_, err := sts.GetCallerIdentity()
+
+	if !awserrors.IsAccessDenied(err) {
+		t.Errorf("Expected an access denied error, got %v", err)
+	}
+}